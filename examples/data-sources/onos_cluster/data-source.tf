@@ -0,0 +1,9 @@
+# Fail the plan when a controller node is not ready.
+data "onos_cluster" "this" {
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for node in self.nodes : node.status == "READY"])
+      error_message = "All ONOS cluster nodes must be READY."
+    }
+  }
+}
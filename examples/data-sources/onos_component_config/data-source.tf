@@ -0,0 +1,7 @@
+data "onos_component_config" "lldp" {
+  component = "org.onosproject.provider.lldp.impl.LldpLinkProvider"
+}
+
+output "lldp_probe_rate" {
+  value = data.onos_component_config.lldp.properties["probeRate"].value
+}
@@ -0,0 +1,7 @@
+data "onos_flow_table_statistics" "leaf1" {
+  device_id = "of:0000000000000001"
+}
+
+output "leaf1_flow_entries" {
+  value = sum([for table in data.onos_flow_table_statistics.leaf1.tables : table.active_entries])
+}
@@ -0,0 +1,13 @@
+data "onos_link_statistics" "leaf1" {
+  device_id = "of:0000000000000001"
+}
+
+locals {
+  # The least loaded uplink of leaf1, e.g. to use as an intent waypoint.
+  leaf1_uplinks = [for link in data.onos_link_statistics.leaf1.links : link if link.valid]
+  least_loaded  = [for link in local.leaf1_uplinks : link if link.rate == min(local.leaf1_uplinks[*].rate...)][0]
+}
+
+output "least_loaded_spine" {
+  value = local.least_loaded.dst.elementid
+}
@@ -0,0 +1,2 @@
+# List the current master and standbys of every device.
+data "onos_mastership" "all" {}
@@ -0,0 +1,5 @@
+data "onos_mcast_routes" "all" {}
+
+output "mcast_groups" {
+  value = distinct(data.onos_mcast_routes.all.routes[*].group)
+}
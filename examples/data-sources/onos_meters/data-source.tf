@@ -0,0 +1,4 @@
+# List all meters of a device.
+data "onos_meters" "s1" {
+  device_id = "of:0000000000000001"
+}
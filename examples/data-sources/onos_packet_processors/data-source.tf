@@ -0,0 +1,9 @@
+data "onos_packet_processors" "all" {}
+
+# Processors that take the most controller time handling PACKET_INs.
+output "busiest_processors" {
+  value = [
+    for p in data.onos_packet_processors.all.processors : "${p.processor} (${p.type})"
+    if p.total_nanos > 1000000000
+  ]
+}
@@ -0,0 +1,11 @@
+data "onos_port_statistics" "leaf1" {
+  device_id = "of:0000000000000001"
+}
+
+# Stop the rollout when a port of leaf1 reports errors.
+check "leaf1_port_errors" {
+  assert {
+    condition     = alltrue([for port in data.onos_port_statistics.leaf1.ports : port.packets_rx_errors + port.packets_tx_errors == 0])
+    error_message = "A port of leaf1 reports errors."
+  }
+}
@@ -0,0 +1,5 @@
+data "onos_regions" "all" {}
+
+output "region_devices" {
+  value = { for region in data.onos_regions.all.regions : region.id => region.devices }
+}
@@ -0,0 +1,10 @@
+data "onos_routes" "all" {}
+
+# Routes whose next hop has not been learned as a host yet.
+output "unresolved_routes" {
+  value = flatten([
+    for table in data.onos_routes.all.tables : [
+      for route in table.routes : route.prefix if route.next_hop_mac == null
+    ]
+  ])
+}
@@ -0,0 +1,13 @@
+data "onos_system" "current" {}
+
+# Fail the plan early when pointed at the wrong controller.
+check "onos_cluster" {
+  assert {
+    condition     = startswith(data.onos_system.current.version, "2.")
+    error_message = "Expected ONOS 2.x, got ${data.onos_system.current.version}."
+  }
+}
+
+output "onos_version" {
+  value = data.onos_system.current.version
+}
@@ -4,3 +4,34 @@ provider "onos" {
   username = "onos"
   password = "rocks"
 }
+
+# HTTPS with a private CA and mutual TLS
+provider "onos" {
+  alias        = "production"
+  host         = "https://onos.example.org:8443/onos/v1"
+  username     = "onos"
+  password     = "rocks"
+  ca_cert_file = "/etc/ssl/onos/ca.pem"
+  client_cert  = "/etc/ssl/onos/terraform.pem"
+  client_key   = "/etc/ssl/onos/terraform-key.pem"
+}
+
+# ONOS cluster, failing over to the next node when one is down
+provider "onos" {
+  alias = "cluster"
+  hosts = [
+    "http://onos1:8181/onos/v1",
+    "http://onos2:8181/onos/v1",
+    "http://onos3:8181/onos/v1",
+  ]
+  username = "onos"
+  password = "rocks"
+}
+
+# Behind an OAuth2 reverse proxy
+provider "onos" {
+  alias      = "proxied"
+  host       = "https://onos.example.org/onos/v1"
+  auth_mode  = "bearer"
+  token_file = "/var/run/secrets/onos/token"
+}
@@ -0,0 +1,8 @@
+# Make reactive forwarding install flows matching on IPv4 addresses.
+resource "onos_component_config" "fwd" {
+  component = "org.onosproject.fwd.ReactiveForwarding"
+  properties = {
+    matchIpv4Address = "true"
+    flowTimeout      = "30"
+  }
+}
@@ -0,0 +1,2 @@
+# Devices can be imported by specifying the Device ID.
+terraform import onos_device.old_leaf "of:0000000000000003"
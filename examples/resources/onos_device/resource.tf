@@ -0,0 +1,7 @@
+# Remove a retired switch together with everything that still references it.
+resource "onos_device" "old_leaf" {
+  device_id    = "of:0000000000000003"
+  purge_flows  = true
+  purge_groups = true
+  purge_hosts  = true
+}
@@ -0,0 +1,2 @@
+# Device config can be imported by specifying the Device ID.
+terraform import onos_device_config.leaf1 "of:0000000000000001"
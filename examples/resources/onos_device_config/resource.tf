@@ -0,0 +1,14 @@
+# Name and locate a leaf switch.
+resource "onos_device_config" "leaf1" {
+  device_id    = "of:0000000000000001"
+  name         = "leaf1"
+  rack_address = "dc1-r01"
+  owner        = "fabric-team"
+  location = {
+    latitude  = 52.37
+    longitude = 4.89
+  }
+  annotations = {
+    role = "leaf"
+  }
+}
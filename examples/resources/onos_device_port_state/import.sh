@@ -0,0 +1,2 @@
+# Port state can be imported by specifying the Device ID and Port (Device ID: of:0000000000000001, Port: 3).
+terraform import onos_device_port_state.s1_uplink "of:0000000000000001/3"
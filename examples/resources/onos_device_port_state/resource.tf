@@ -0,0 +1,7 @@
+# Shut an uplink for a maintenance window and bring it back up on destroy.
+resource "onos_device_port_state" "s1_uplink" {
+  device_id          = "of:0000000000000001"
+  port               = "3"
+  enabled            = false
+  restore_on_destroy = true
+}
@@ -0,0 +1,2 @@
+# DHCP relay config can be imported by specifying the app name.
+terraform import onos_dhcp_relay.fabric org.onosproject.dhcprelay
@@ -0,0 +1,16 @@
+# Relay DHCP requests of the fabric hosts to the server behind leaf2.
+resource "onos_dhcp_relay" "fabric" {
+  default_servers = [
+    {
+      connect_point = "of:0000000000000002/2"
+      server_ips    = ["10.0.3.252", "2000::3:252"]
+    },
+  ]
+  indirect_servers = [
+    {
+      connect_point = "of:0000000000000002/2"
+      server_ips    = ["10.0.3.252"]
+      gateway_ips   = ["10.0.3.254"]
+    },
+  ]
+}
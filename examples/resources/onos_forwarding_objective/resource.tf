@@ -0,0 +1,27 @@
+# Hand IPv4 traffic for 10.0.1.0/24 to a next objective, policed by a meter.
+resource "onos_forwarding_objective" "tenant_a" {
+  device_id = "of:0000000000000001"
+  flag      = "VERSATILE"
+  priority  = 40000
+  selector = {
+    criteria = [
+      {
+        type     = "ETH_TYPE"
+        eth_type = "0x0800"
+      },
+      {
+        type = "IPV4_DST"
+        ip   = "10.0.1.0/24"
+      },
+    ]
+  }
+  treatment = {
+    instructions = [
+      {
+        type     = "METER"
+        meter_id = onos_meter.tenant_a.meter_id
+      },
+    ]
+  }
+  next_id = onos_next_objective.uplink.next_id
+}
@@ -0,0 +1,2 @@
+# Mastership can be imported by specifying the Device ID.
+terraform import onos_mastership.leaf1 "of:0000000000000001"
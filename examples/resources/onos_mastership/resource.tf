@@ -0,0 +1,6 @@
+# Pin leaf1 to the first controller, failing over to the second and third.
+resource "onos_mastership" "leaf1" {
+  device_id      = "of:0000000000000001"
+  master_node_id = "172.17.0.2"
+  standbys       = ["172.17.0.3", "172.17.0.4"]
+}
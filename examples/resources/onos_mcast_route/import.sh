@@ -0,0 +1,2 @@
+# Multicast route can be imported by specifying the source and group.
+terraform import onos_mcast_route.channel1 "10.0.0.1/224.1.1.1"
@@ -0,0 +1,9 @@
+# Deliver the IPTV channel of the head end to two access leaves.
+resource "onos_mcast_route" "channel1" {
+  source = "10.0.0.1"
+  group  = "224.1.1.1"
+  sinks = [
+    "of:0000000000000002/3",
+    "of:0000000000000003/3",
+  ]
+}
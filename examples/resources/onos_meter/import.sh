@@ -0,0 +1,2 @@
+# Meters can be imported by specifying the Device ID and Meter ID (Device ID: of:0000000000000001, Meter ID: 1).
+terraform import onos_meter.tenant_a "of:0000000000000001/1"
@@ -0,0 +1,13 @@
+# Police a tenant to 10 Mbps with a 1 Mb burst.
+resource "onos_meter" "tenant_a" {
+  device_id = "of:0000000000000001"
+  unit      = "KB_PER_SEC"
+  burst     = true
+  bands = [
+    {
+      type       = "DROP"
+      rate       = 10000
+      burst_size = 1000
+    },
+  ]
+}
@@ -0,0 +1,20 @@
+# Send traffic out of port 2 after pushing VLAN 100.
+resource "onos_next_objective" "uplink" {
+  device_id = "of:0000000000000001"
+  type      = "SIMPLE"
+  treatments = [
+    {
+      instructions = [
+        {
+          type    = "L2MODIFICATION"
+          subtype = "VLAN_ID"
+          vlan_id = 100
+        },
+        {
+          type = "OUTPUT"
+          port = "2"
+        },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Region can be imported by specifying the Region ID.
+terraform import onos_region.pod1 pod1
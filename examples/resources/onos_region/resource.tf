@@ -0,0 +1,11 @@
+# Group the leaves of the first pod into a data center region.
+resource "onos_region" "pod1" {
+  id      = "pod1"
+  name    = "Pod 1"
+  type    = "DATA_CENTER"
+  masters = [["172.17.0.2", "172.17.0.3"], ["172.17.0.4"]]
+  devices = [
+    "of:0000000000000001",
+    "of:0000000000000002",
+  ]
+}
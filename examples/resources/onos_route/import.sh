@@ -0,0 +1,2 @@
+# Route can be imported by specifying the prefix and next hop.
+terraform import onos_route.site2 "10.2.0.0/16,10.0.1.254"
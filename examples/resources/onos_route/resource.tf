@@ -0,0 +1,5 @@
+# Send the traffic of the remote site through the border router.
+resource "onos_route" "site2" {
+  prefix   = "10.2.0.0/16"
+  next_hop = "10.0.1.254"
+}
@@ -0,0 +1,15 @@
+# Seed the routes of all remote sites in one request.
+resource "onos_route_bulk" "sites" {
+  routes = [for site, prefix in var.site_prefixes : {
+    prefix   = prefix
+    next_hop = "10.0.1.254"
+  }]
+}
+
+variable "site_prefixes" {
+  type = map(string)
+  default = {
+    site2 = "10.2.0.0/16"
+    site3 = "10.3.0.0/16"
+  }
+}
@@ -0,0 +1,2 @@
+# Segment routing config can be imported by specifying the Device ID.
+terraform import onos_segment_routing_device.leaf1 "of:0000000000000001"
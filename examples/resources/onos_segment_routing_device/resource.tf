@@ -0,0 +1,15 @@
+# Segment routing config of the first leaf of the fabric.
+resource "onos_segment_routing_device" "leaf1" {
+  device_id      = "of:0000000000000001"
+  name           = "leaf1"
+  ipv4_node_sid  = 101
+  ipv4_loopback  = "192.168.0.1"
+  router_mac     = "00:00:00:00:01:80"
+  is_edge_router = true
+  adjacency_sids = [
+    {
+      adj_sid = 100001
+      ports   = [3, 4]
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Port subnets can be imported by specifying the connect point of the port.
+terraform import onos_segment_routing_subnet.leaf1_h1 "of:0000000000000001/3"
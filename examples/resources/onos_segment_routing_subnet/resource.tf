@@ -0,0 +1,7 @@
+# Subnet of the hosts on port 3 of the first leaf of the fabric.
+resource "onos_segment_routing_subnet" "leaf1_h1" {
+  connect_point = "of:0000000000000001/3"
+  name          = "leaf1-h1"
+  subnets       = ["10.0.1.254/24"]
+  vlan_untagged = 10
+}
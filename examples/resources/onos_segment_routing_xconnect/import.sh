@@ -0,0 +1,2 @@
+# Xconnect can be imported by specifying the Device ID and VLAN ID.
+terraform import onos_segment_routing_xconnect.olt "of:0000000000000001/100"
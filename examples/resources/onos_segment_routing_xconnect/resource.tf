@@ -0,0 +1,6 @@
+# Cross connect the OLT on port 1 of leaf1 to the BNG on port 2 on VLAN 100.
+resource "onos_segment_routing_xconnect" "olt" {
+  device_id = "of:0000000000000001"
+  vlan_id   = 100
+  endpoints = ["1", "2"]
+}
@@ -0,0 +1,2 @@
+# VPLS can be imported by specifying the VPLS name.
+terraform import onos_vpls.tenant1 tenant1
@@ -0,0 +1,6 @@
+# Bridge the interfaces of tenant 1 over a VLAN encapsulated VPLS.
+resource "onos_vpls" "tenant1" {
+  name          = "tenant1"
+  encapsulation = "VLAN"
+  interfaces    = ["leaf1-h1", "leaf2-h1"]
+}
@@ -0,0 +1,419 @@
+package onosfake
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+// Intent states of the intents installed through the API. An intent moves to
+// the next state every time it is read, as ONOS compiles and installs it in
+// the background.
+const (
+	intentInstallReq = "INSTALL_REQ"
+	intentInstalling = "INSTALLING"
+	intentInstalled  = "INSTALLED"
+	intentFailed     = "FAILED"
+)
+
+// Application states.
+const (
+	appStateActive    = "ACTIVE"
+	appStateInstalled = "INSTALLED"
+)
+
+// newRoutes returns the API endpoints served by s. More specific patterns come
+// first, e.g. devices/ports before devices/*.
+func (s *Server) newRoutes() []route {
+	return []route{
+		{"GET", []string{"system"}, s.getSystem},
+
+		{"GET", []string{"hosts"}, s.getHosts},
+		{"GET", []string{"hosts", "*", "*"}, s.getHost},
+		{"DELETE", []string{"hosts", "*", "*"}, s.deleteHost},
+
+		{"GET", []string{"devices"}, s.getDevices},
+		{"GET", []string{"devices", "ports"}, s.getAllPorts},
+		{"GET", []string{"devices", "*"}, s.getDevice},
+		{"DELETE", []string{"devices", "*"}, s.deleteDevice},
+		{"GET", []string{"devices", "*", "ports"}, s.getDevicePorts},
+		{"POST", []string{"devices", "*", "portstate", "*"}, s.setPortState},
+
+		{"GET", []string{"links"}, s.getLinks},
+
+		{"GET", []string{"flows"}, s.getFlows},
+		{"GET", []string{"flows", "*"}, s.getDeviceFlows},
+		{"POST", []string{"flows", "*"}, s.createFlow},
+		{"GET", []string{"flows", "*", "*"}, s.getFlow},
+		{"DELETE", []string{"flows", "*", "*"}, s.deleteFlow},
+
+		{"GET", []string{"groups"}, s.getGroups},
+		{"GET", []string{"groups", "*"}, s.getDeviceGroups},
+		{"DELETE", []string{"groups", "*", "*"}, s.deleteGroup},
+
+		{"GET", []string{"intents"}, s.getIntents},
+		{"POST", []string{"intents"}, s.submitIntent},
+		{"GET", []string{"intents", "*", "*"}, s.getIntent},
+		{"DELETE", []string{"intents", "*", "*"}, s.withdrawIntent},
+
+		{"GET", []string{"applications"}, s.getApplications},
+		{"GET", []string{"applications", "*"}, s.getApplication},
+		{"POST", []string{"applications", "*", "active"}, s.activateApplication},
+		{"DELETE", []string{"applications", "*", "active"}, s.deactivateApplication},
+
+		{"GET", []string{"network", "configuration"}, s.getConfig},
+		{"GET", []string{"network", "configuration", "*"}, s.getConfig},
+		{"GET", []string{"network", "configuration", "*", "*"}, s.getConfig},
+		{"GET", []string{"network", "configuration", "*", "*", "*"}, s.getConfig},
+		{"POST", []string{"network", "configuration"}, s.postConfig},
+		{"POST", []string{"network", "configuration", "*"}, s.postConfig},
+		{"POST", []string{"network", "configuration", "*", "*"}, s.postConfig},
+		{"POST", []string{"network", "configuration", "*", "*", "*"}, s.postConfig},
+		{"DELETE", []string{"network", "configuration"}, s.deleteConfig},
+		{"DELETE", []string{"network", "configuration", "*"}, s.deleteConfig},
+		{"DELETE", []string{"network", "configuration", "*", "*"}, s.deleteConfig},
+		{"DELETE", []string{"network", "configuration", "*", "*", "*"}, s.deleteConfig},
+	}
+}
+
+func (s *Server) getSystem(w http.ResponseWriter, _ *http.Request, _ []string) {
+	st := s.state
+
+	system := object{"nodes": 1}
+	for field, value := range st.system {
+		system[field] = value
+	}
+	system["devices"] = len(st.devices)
+	system["links"] = len(st.links)
+	system["hosts"] = len(st.hosts)
+	system["flows"] = len(st.flows)
+	system["intents"] = len(st.intents)
+	system["sccs"] = min(len(st.devices), 1)
+	writeJSON(w, http.StatusOK, system)
+}
+
+func (s *Server) getHosts(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, object{"hosts": filter(s.state.hosts, all)})
+}
+
+func (s *Server) getHost(w http.ResponseWriter, _ *http.Request, args []string) {
+	i := find(s.state.hosts, fieldIs("id", args[0]+"/"+args[1]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Host is not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.state.hosts[i])
+}
+
+func (s *Server) deleteHost(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	i := find(st.hosts, fieldIs("id", args[0]+"/"+args[1]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Host is not found")
+		return
+	}
+	st.hosts = slices.Delete(st.hosts, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getDevices(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, object{"devices": filter(s.state.devices, all)})
+}
+
+func (s *Server) getDevice(w http.ResponseWriter, _ *http.Request, args []string) {
+	i := find(s.state.devices, fieldIs("id", args[0]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Device is not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.state.devices[i])
+}
+
+// deleteDevice removes a device with its ports, links, flows and groups. The
+// hosts attached to the device are kept, as in ONOS.
+func (s *Server) deleteDevice(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	deviceID := args[0]
+	i := find(st.devices, fieldIs("id", deviceID))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Device is not found")
+		return
+	}
+
+	st.devices = slices.Delete(st.devices, i, i+1)
+	st.ports = slices.DeleteFunc(st.ports, fieldIs("element", deviceID))
+	st.flows = slices.DeleteFunc(st.flows, fieldIs("deviceId", deviceID))
+	st.groups = slices.DeleteFunc(st.groups, fieldIs("deviceId", deviceID))
+	st.links = slices.DeleteFunc(st.links, func(link object) bool {
+		return linkEnd(link, "src") == deviceID || linkEnd(link, "dst") == deviceID
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// linkEnd returns the device of the src or dst end of a link.
+func linkEnd(link object, end string) string {
+	point, _ := link[end].(object)
+	return str(point, "device")
+}
+
+func (s *Server) getAllPorts(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, object{"ports": filter(s.state.ports, all)})
+}
+
+// getDevicePorts answers with the device and its ports, as ONOS does.
+func (s *Server) getDevicePorts(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	i := find(st.devices, fieldIs("id", args[0]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Device is not found")
+		return
+	}
+
+	device := object{}
+	for field, value := range st.devices[i] {
+		device[field] = value
+	}
+	device["ports"] = filter(st.ports, fieldIs("element", args[0]))
+	writeJSON(w, http.StatusOK, device)
+}
+
+func (s *Server) setPortState(w http.ResponseWriter, r *http.Request, args []string) {
+	var body struct {
+		Enabled *bool `json:"enabled"`
+	}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Enabled == nil {
+		writeError(w, http.StatusBadRequest, "enabled is required")
+		return
+	}
+
+	i := find(s.state.ports, func(port object) bool {
+		return str(port, "element") == args[0] && str(port, "port") == args[1]
+	})
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Port is not found")
+		return
+	}
+	s.state.ports[i]["isEnabled"] = *body.Enabled
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getLinks(w http.ResponseWriter, r *http.Request, _ []string) {
+	deviceID := r.URL.Query().Get("device")
+	links := filter(s.state.links, func(link object) bool {
+		return deviceID == "" || linkEnd(link, "src") == deviceID || linkEnd(link, "dst") == deviceID
+	})
+	writeJSON(w, http.StatusOK, object{"links": links})
+}
+
+func (s *Server) getFlows(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, object{"flows": filter(s.state.flows, all)})
+}
+
+func (s *Server) getDeviceFlows(w http.ResponseWriter, _ *http.Request, args []string) {
+	writeJSON(w, http.StatusOK, object{"flows": filter(s.state.flows, fieldIs("deviceId", args[0]))})
+}
+
+func (s *Server) getFlow(w http.ResponseWriter, _ *http.Request, args []string) {
+	flows := filter(s.state.flows, func(flow object) bool {
+		return str(flow, "deviceId") == args[0] && str(flow, "id") == args[1]
+	})
+	if len(flows) == 0 {
+		writeError(w, http.StatusNotFound, "Flow is not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{"flows": flows})
+}
+
+// createFlow adds a flow rule to a device. Flows are added right away, ONOS
+// would also go through PENDING_ADD.
+func (s *Server) createFlow(w http.ResponseWriter, r *http.Request, args []string) {
+	st := s.state
+	if find(st.devices, fieldIs("id", args[0])) < 0 {
+		writeError(w, http.StatusNotFound, "Device is not found")
+		return
+	}
+
+	flow := object{}
+	if !readJSON(w, r, &flow) {
+		return
+	}
+	appID := r.URL.Query().Get("appId")
+	if appID == "" {
+		appID = "org.onosproject.rest"
+	}
+	id := strconv.Itoa(st.newID())
+	flow["id"] = id
+	flow["deviceId"] = args[0]
+	flow["appId"] = appID
+	flow["state"] = "ADDED"
+	st.flows = append(st.flows, flow)
+
+	w.Header().Set("Location", APIPath+"/flows/"+url.PathEscape(args[0])+"/"+id)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) deleteFlow(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	st.flows = slices.DeleteFunc(st.flows, func(flow object) bool {
+		return str(flow, "deviceId") == args[0] && str(flow, "id") == args[1]
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getGroups(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, object{"groups": filter(s.state.groups, all)})
+}
+
+func (s *Server) getDeviceGroups(w http.ResponseWriter, _ *http.Request, args []string) {
+	writeJSON(w, http.StatusOK, object{"groups": filter(s.state.groups, fieldIs("deviceId", args[0]))})
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	st.groups = slices.DeleteFunc(st.groups, func(group object) bool {
+		return str(group, "deviceId") == args[0] && str(group, "appCookie") == args[1]
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getIntents(w http.ResponseWriter, _ *http.Request, _ []string) {
+	st := s.state
+	for _, intent := range st.intents {
+		st.advanceIntent(intent)
+	}
+	writeJSON(w, http.StatusOK, object{"intents": filter(st.intents, all)})
+}
+
+func (s *Server) getIntent(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	i := find(st.intents, intentIs(args[0], args[1]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Intent is not found")
+		return
+	}
+	st.advanceIntent(st.intents[i])
+	writeJSON(w, http.StatusOK, st.intents[i])
+}
+
+// submitIntent installs an intent, or replaces the intent with the same app
+// ID and key, which keeps its ID. The intent is then installed in the
+// background, see advanceIntent.
+func (s *Server) submitIntent(w http.ResponseWriter, r *http.Request, _ []string) {
+	st := s.state
+
+	intent := object{}
+	if !readJSON(w, r, &intent) {
+		return
+	}
+	appID, key := str(intent, "appId"), str(intent, "key")
+	if appID == "" || str(intent, "type") == "" {
+		writeError(w, http.StatusBadRequest, "appId and type are required")
+		return
+	}
+	if key == "" {
+		key = fmt.Sprintf("0x%x", st.newID())
+		intent["key"] = key
+	}
+	intent["state"] = intentInstallReq
+
+	if i := find(st.intents, intentIs(appID, key)); i >= 0 {
+		intent["id"] = st.intents[i]["id"]
+		st.intents[i] = intent
+	} else {
+		intent["id"] = fmt.Sprintf("0x%x", st.newID())
+		st.intents = append(st.intents, intent)
+	}
+
+	w.Header().Set("Location", APIPath+"/intents/"+url.PathEscape(appID)+"/"+url.PathEscape(key))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// withdrawIntent withdraws and purges an intent. ONOS answers with 204 whether
+// or not the intent exists.
+func (s *Server) withdrawIntent(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	st.intents = slices.DeleteFunc(st.intents, intentIs(args[0], args[1]))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func intentIs(appID, key string) func(object) bool {
+	return func(intent object) bool {
+		return str(intent, "appId") == appID && str(intent, "key") == key
+	}
+}
+
+// advanceIntent moves an intent being installed to its next state. Host to
+// host intents between unknown hosts fail to compile, as in ONOS.
+func (st *state) advanceIntent(intent object) {
+	switch str(intent, "state") {
+	case intentInstallReq:
+		intent["state"] = intentInstalling
+	case intentInstalling:
+		intent["state"] = intentInstalled
+		if str(intent, "type") == "HostToHostIntent" &&
+			(find(st.hosts, fieldIs("id", str(intent, "one"))) < 0 || find(st.hosts, fieldIs("id", str(intent, "two"))) < 0) {
+			intent["state"] = intentFailed
+		}
+	}
+}
+
+func (s *Server) getApplications(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, object{"applications": filter(s.state.applications, all)})
+}
+
+func (s *Server) getApplication(w http.ResponseWriter, _ *http.Request, args []string) {
+	i := find(s.state.applications, fieldIs("name", args[0]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "App is not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.state.applications[i])
+}
+
+// activateApplication activates an application with the applications it
+// requires.
+func (s *Server) activateApplication(w http.ResponseWriter, _ *http.Request, args []string) {
+	st := s.state
+	i := find(st.applications, fieldIs("name", args[0]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "App is not found")
+		return
+	}
+	st.activate(args[0])
+	writeJSON(w, http.StatusOK, st.applications[i])
+}
+
+func (st *state) activate(name string) {
+	i := find(st.applications, fieldIs("name", name))
+	if i < 0 || str(st.applications[i], "state") == appStateActive {
+		return
+	}
+	st.applications[i]["state"] = appStateActive
+
+	required, _ := st.applications[i]["requiredApps"].([]any)
+	for _, app := range required {
+		if requiredName, ok := app.(string); ok {
+			st.activate(requiredName)
+		}
+	}
+}
+
+func (s *Server) deactivateApplication(w http.ResponseWriter, _ *http.Request, args []string) {
+	i := find(s.state.applications, fieldIs("name", args[0]))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "App is not found")
+		return
+	}
+	s.state.applications[i]["state"] = appStateInstalled
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// all matches every object.
+func all(object) bool {
+	return true
+}
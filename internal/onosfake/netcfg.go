@@ -0,0 +1,124 @@
+package onosfake
+
+import (
+	"net/http"
+)
+
+// The network configuration is a tree of subject classes, subjects and
+// config keys, e.g. devices/of:0000000000000001/basic, each key holding a
+// JSON config. The handlers serve every level of the tree, the path arguments
+// being the levels below /network/configuration.
+
+// netcfgDepth is the number of levels above the configs of the tree.
+const netcfgDepth = 3
+
+func (s *Server) getConfig(w http.ResponseWriter, _ *http.Request, args []string) {
+	var node any = s.state.netcfg
+	for _, name := range args {
+		parent, _ := node.(object)
+		child, ok := parent[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Config is not found")
+			return
+		}
+		node = child
+	}
+	writeJSON(w, http.StatusOK, node)
+}
+
+// postConfig sets the configs in the request body. Above the config key level
+// the body is merged into the tree, replacing only the configs it holds.
+func (s *Server) postConfig(w http.ResponseWriter, r *http.Request, args []string) {
+	var body any
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	if len(args) == netcfgDepth {
+		node := s.state.netcfg
+		for _, name := range args[:netcfgDepth-1] {
+			node = childConfig(node, name)
+		}
+		node[args[netcfgDepth-1]] = body
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	levels := netcfgDepth - len(args)
+	configs, ok := body.(object)
+	if !ok || !isConfigTree(configs, levels) {
+		writeError(w, http.StatusBadRequest, "Invalid configuration, expected JSON objects down to the config keys")
+		return
+	}
+	node := s.state.netcfg
+	for _, name := range args {
+		node = childConfig(node, name)
+	}
+	mergeConfig(node, configs, levels)
+	w.WriteHeader(http.StatusOK)
+}
+
+// childConfig returns the child of a tree node, adding it when missing.
+func childConfig(node object, name string) object {
+	child, ok := node[name].(object)
+	if !ok {
+		child = object{}
+		node[name] = child
+	}
+	return child
+}
+
+// isConfigTree reports whether tree is made of JSON objects down to the
+// configs, levels being the number of levels above the configs.
+func isConfigTree(tree object, levels int) bool {
+	if levels == 1 {
+		return true
+	}
+	for _, value := range tree {
+		child, ok := value.(object)
+		if !ok || !isConfigTree(child, levels-1) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeConfig merges the src tree into the dst one, levels being the number of
+// levels above the configs.
+func mergeConfig(dst, src object, levels int) {
+	for name, value := range src {
+		if levels == 1 {
+			dst[name] = value
+			continue
+		}
+		child, _ := value.(object)
+		mergeConfig(childConfig(dst, name), child, levels-1)
+	}
+}
+
+// deleteConfig removes a part of the tree and the subjects and subject
+// classes left empty. ONOS answers with 204 whether or not it exists.
+func (s *Server) deleteConfig(w http.ResponseWriter, _ *http.Request, args []string) {
+	if len(args) == 0 {
+		s.state.netcfg = object{}
+	} else {
+		removeConfig(s.state.netcfg, args)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func removeConfig(node object, names []string) {
+	if len(names) == 1 {
+		delete(node, names[0])
+		return
+	}
+
+	child, ok := node[names[0]].(object)
+	if !ok {
+		return
+	}
+	removeConfig(child, names[1:])
+	if len(child) == 0 {
+		delete(node, names[0])
+	}
+}
@@ -0,0 +1,304 @@
+// Package onosfake provides an in-memory stand-in of the ONOS REST API, so the
+// provider acceptance tests can run without an ONOS controller.
+//
+// The server is seeded from JSON fixtures shaped like the ONOS API responses,
+// see Fixtures for the default Mininet topology of examples/docker-compose.yaml.
+// It serves the hosts, devices, links, flows, groups, intents, applications
+// and network configuration endpoints, and can inject faults to test the
+// error paths of the provider.
+package onosfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIPath is the base path of the API served by the server.
+const APIPath = "/onos/v1"
+
+// Default credentials of the server, the ones of the ONOS distribution.
+const (
+	DefaultUsername = "onos"
+	DefaultPassword = "rocks"
+)
+
+// Server is an in-memory ONOS REST API. It implements http.Handler, e.g. to be
+// served by an httptest.Server. It is safe for concurrent use.
+type Server struct {
+	fixtures fs.FS
+
+	mu          sync.Mutex
+	credentials credentials
+	faults      []*Fault
+	state       *state
+	routes      []route
+}
+
+// credentials are the credentials accepted by the server. Requests are
+// authorized by basic credentials, or by a bearer token when it is set.
+type credentials struct {
+	username string
+	password string
+	token    string
+}
+
+// New returns a server seeded from the fixtures in fixtures. Every fixture
+// file is optional, a missing file seeds no objects:
+//
+//	system.json        the /system response, the counts are computed
+//	hosts.json         the /hosts response
+//	devices.json       the /devices response
+//	ports.json         the /devices/ports response
+//	links.json         the /links response
+//	flows.json         the /flows response
+//	groups.json        the /groups response
+//	intents.json       the /intents response
+//	applications.json  the /applications response
+//	netcfg.json        the /network/configuration response
+func New(fixtures fs.FS) (*Server, error) {
+	s := &Server{
+		fixtures: fixtures,
+		credentials: credentials{
+			username: DefaultUsername,
+			password: DefaultPassword,
+		},
+	}
+	s.routes = s.newRoutes()
+	if err := s.Reset(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reset restores the objects of the server from its fixtures and clears the
+// injected faults.
+func (s *Server) Reset() error {
+	st, err := loadState(s.fixtures)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = st
+	s.faults = nil
+	return nil
+}
+
+// SetBasicAuth sets the basic credentials accepted by the server.
+func (s *Server) SetBasicAuth(username, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.credentials.username = username
+	s.credentials.password = password
+}
+
+// SetToken makes the server accept the bearer token instead of basic
+// credentials. An empty token restores basic authentication.
+func (s *Server) SetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.credentials.token = token
+}
+
+// Fault is an error injected into the responses of the server.
+type Fault struct {
+	// Method is the method of the requests to fail, any method when empty.
+	Method string
+	// Path is the prefix of the API paths to fail, relative to APIPath,
+	// e.g. /intents. Any path when empty.
+	Path string
+	// Latency delays the response.
+	Latency time.Duration
+	// Status is returned instead of the response when set, e.g. 401 or 503.
+	Status int
+	// Times is the number of requests to fail, until the faults are cleared
+	// when 0.
+	Times int
+}
+
+// InjectFault adds a fault to the responses of the server. Faults are matched
+// in the order they are injected, and only the first matching fault applies.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// takeFault returns the first fault matching a request, or nil.
+func (s *Server) takeFault(method, apiPath string) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != method {
+			continue
+		}
+		if !strings.HasPrefix(apiPath, f.Path) {
+			continue
+		}
+		fault := *f
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return &fault
+	}
+	return nil
+}
+
+// ServeHTTP serves the ONOS REST API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	apiPath, ok := strings.CutPrefix(r.URL.EscapedPath(), APIPath)
+	if !ok || (apiPath != "" && !strings.HasPrefix(apiPath, "/")) {
+		writeError(w, http.StatusNotFound, "HTTP 404 Not Found")
+		return
+	}
+
+	if fault := s.takeFault(r.Method, apiPath); fault != nil {
+		if fault.Latency > 0 {
+			timer := time.NewTimer(fault.Latency)
+			select {
+			case <-timer.C:
+			case <-r.Context().Done():
+				timer.Stop()
+				return
+			}
+		}
+		if fault.Status != 0 {
+			writeError(w, fault.Status, http.StatusText(fault.Status))
+			return
+		}
+	}
+
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="karaf"`)
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	segments, err := splitPath(apiPath)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	methodAllowed := false
+	for _, rt := range s.routes {
+		args, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			methodAllowed = true
+			continue
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		rt.handler(w, r, args)
+		return
+	}
+
+	if methodAllowed {
+		writeError(w, http.StatusMethodNotAllowed, "HTTP 405 Method Not Allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "HTTP 404 Not Found")
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.credentials.token != "" {
+		return r.Header.Get("Authorization") == "Bearer "+s.credentials.token
+	}
+	username, password, ok := r.BasicAuth()
+	return ok && username == s.credentials.username && password == s.credentials.password
+}
+
+// splitPath returns the unescaped segments of an API path, so subjects such as
+// of:0000000000000001/1 can be escaped into a single segment.
+func splitPath(apiPath string) ([]string, error) {
+	apiPath = strings.Trim(apiPath, "/")
+	if apiPath == "" {
+		return nil, nil
+	}
+
+	segments := strings.Split(apiPath, "/")
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path segment %q", segment)
+		}
+		segments[i] = unescaped
+	}
+	return segments, nil
+}
+
+// route is an API endpoint. The "*" segments of the pattern match any segment
+// and are passed to the handler.
+type route struct {
+	method  string
+	pattern []string
+	handler func(w http.ResponseWriter, r *http.Request, args []string)
+}
+
+func (rt route) match(segments []string) ([]string, bool) {
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+
+	var args []string
+	for i, segment := range rt.pattern {
+		switch segment {
+		case "*":
+			args = append(args, segments[i])
+		case segments[i]:
+		default:
+			return nil, false
+		}
+	}
+	return args, true
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response shaped like the ONOS ones.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"code":    status,
+		"message": message,
+	})
+}
+
+// readJSON decodes the JSON request body into v, answering with a 400 error
+// when it fails.
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON: "+err.Error())
+		return false
+	}
+	return true
+}
@@ -0,0 +1,334 @@
+package onosfake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testServer serves a fake seeded from the default fixtures.
+type testServer struct {
+	*Server
+	url string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	fake, err := New(Fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return &testServer{Server: fake, url: server.URL + APIPath}
+}
+
+// do sends an authenticated request and decodes the JSON response body into
+// out when it is non-nil. It returns the response status.
+func (s *testServer) do(t *testing.T, method, path, body string, out any) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, s.url+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(DefaultUsername, DefaultPassword)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if out != nil && res.StatusCode < 300 {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			t.Fatalf("%s %s: decoding the response: %s", method, path, err)
+		}
+	}
+	return res.StatusCode
+}
+
+func TestServerFixtures(t *testing.T) {
+	s := newTestServer(t)
+
+	var system struct {
+		Version string `json:"version"`
+		Devices int    `json:"devices"`
+		Links   int    `json:"links"`
+		Hosts   int    `json:"hosts"`
+		Flows   int    `json:"flows"`
+	}
+	if status := s.do(t, "GET", "/system", "", &system); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if system.Version != "2.7.0" || system.Devices != 3 || system.Links != 4 || system.Hosts != 4 || system.Flows != 12 {
+		t.Fatalf("unexpected system: %+v", system)
+	}
+
+	var hosts struct {
+		Hosts []struct {
+			ID string `json:"id"`
+		} `json:"hosts"`
+	}
+	s.do(t, "GET", "/hosts", "", &hosts)
+	if len(hosts.Hosts) != 4 || hosts.Hosts[0].ID != "00:00:00:00:00:03/None" {
+		t.Fatalf("unexpected hosts: %+v", hosts)
+	}
+}
+
+func TestServerRoutes(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"GET", "/devices/of:0000000000000001", "", http.StatusOK},
+		{"GET", "/devices/of:0000000000000009", "", http.StatusNotFound},
+		{"GET", "/devices/of:0000000000000002/ports", "", http.StatusOK},
+		{"POST", "/devices/of:0000000000000002/portstate/1", `{"enabled":false}`, http.StatusOK},
+		{"POST", "/devices/of:0000000000000002/portstate/9", `{"enabled":false}`, http.StatusNotFound},
+		{"POST", "/devices/of:0000000000000002/portstate/1", `{}`, http.StatusBadRequest},
+		{"GET", "/hosts/00:00:00:00:00:01/None", "", http.StatusOK},
+		{"GET", "/links", "", http.StatusOK},
+		{"GET", "/flows/of:0000000000000001", "", http.StatusOK},
+		{"GET", "/groups/of:0000000000000001", "", http.StatusOK},
+		{"GET", "/applications/org.onosproject.fwd", "", http.StatusOK},
+		{"GET", "/applications/org.onosproject.unknown", "", http.StatusNotFound},
+		{"GET", "/intents/org.onosproject.cli/0x1", "", http.StatusNotFound},
+		{"POST", "/intents", `{"type":"HostToHostIntent"}`, http.StatusBadRequest},
+		{"PUT", "/hosts", "", http.StatusMethodNotAllowed},
+		{"GET", "/unknown", "", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		if status := s.do(t, tt.method, tt.path, tt.body, nil); status != tt.wantStatus {
+			t.Errorf("%s %s: expected status %d, got %d", tt.method, tt.path, tt.wantStatus, status)
+		}
+	}
+}
+
+func TestServerIntentStates(t *testing.T) {
+	tests := []struct {
+		name       string
+		one        string
+		wantStates []string
+	}{
+		{
+			name:       "known hosts",
+			one:        "00:00:00:00:00:01/None",
+			wantStates: []string{"INSTALLING", "INSTALLED", "INSTALLED"},
+		},
+		{
+			name:       "unknown host",
+			one:        "00:00:00:00:00:99/None",
+			wantStates: []string{"INSTALLING", "FAILED", "FAILED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			body := `{"appId":"org.onosproject.cli","key":"0x99999","type":"HostToHostIntent","one":"` + tt.one + `","two":"00:00:00:00:00:02/None"}`
+			if status := s.do(t, "POST", "/intents", body, nil); status != http.StatusCreated {
+				t.Fatalf("expected status 201, got %d", status)
+			}
+
+			var id string
+			for _, want := range tt.wantStates {
+				var intent map[string]any
+				s.do(t, "GET", "/intents/org.onosproject.cli/0x99999", "", &intent)
+				if intent["state"] != want {
+					t.Fatalf("expected state %s, got %v", want, intent["state"])
+				}
+				if id == "" {
+					id, _ = intent["id"].(string)
+				}
+			}
+
+			// Updating the intent installs it again and keeps its ID.
+			s.do(t, "POST", "/intents", body, nil)
+			var intent map[string]any
+			s.do(t, "GET", "/intents/org.onosproject.cli/0x99999", "", &intent)
+			if intent["state"] != "INSTALLING" || intent["id"] != id {
+				t.Fatalf("expected intent %s to be installed again, got: %v", id, intent)
+			}
+
+			if status := s.do(t, "DELETE", "/intents/org.onosproject.cli/0x99999", "", nil); status != http.StatusNoContent {
+				t.Fatalf("expected status 204, got %d", status)
+			}
+			if status := s.do(t, "GET", "/intents/org.onosproject.cli/0x99999", "", nil); status != http.StatusNotFound {
+				t.Fatalf("expected the intent to be removed, got status %d", status)
+			}
+		})
+	}
+}
+
+func TestServerDeleteDevice(t *testing.T) {
+	s := newTestServer(t)
+
+	if status := s.do(t, "DELETE", "/devices/of:0000000000000003", "", nil); status != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", status)
+	}
+
+	var system struct {
+		Devices int `json:"devices"`
+		Links   int `json:"links"`
+		Flows   int `json:"flows"`
+	}
+	s.do(t, "GET", "/system", "", &system)
+	if system.Devices != 2 || system.Links != 2 || system.Flows != 8 {
+		t.Fatalf("expected the device, its links and flows to be removed, got: %+v", system)
+	}
+
+	if err := s.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if status := s.do(t, "GET", "/devices/of:0000000000000003", "", nil); status != http.StatusOK {
+		t.Fatalf("expected the device to be restored, got status %d", status)
+	}
+}
+
+func TestServerApplications(t *testing.T) {
+	s := newTestServer(t)
+
+	var app struct {
+		State string `json:"state"`
+	}
+	if status := s.do(t, "POST", "/applications/org.onosproject.dhcprelay/active", "", &app); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if app.State != "ACTIVE" {
+		t.Fatalf("expected the app to be active, got %s", app.State)
+	}
+	s.do(t, "GET", "/applications/org.onosproject.route-service", "", &app)
+	if app.State != "ACTIVE" {
+		t.Fatalf("expected the required app to be active, got %s", app.State)
+	}
+
+	s.do(t, "DELETE", "/applications/org.onosproject.dhcprelay/active", "", nil)
+	s.do(t, "GET", "/applications/org.onosproject.dhcprelay", "", &app)
+	if app.State != "INSTALLED" {
+		t.Fatalf("expected the app to be deactivated, got %s", app.State)
+	}
+}
+
+func TestServerNetworkConfiguration(t *testing.T) {
+	s := newTestServer(t)
+	subject := "/network/configuration/devices/of:0000000000000001"
+
+	if status := s.do(t, "GET", subject+"/basic", "", nil); status != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", status)
+	}
+
+	s.do(t, "POST", subject+"/basic", `{"name":"s1"}`, nil)
+	s.do(t, "POST", "/network/configuration", `{"devices":{"of:0000000000000001":{"annotations":{"role":"spine"}}}}`, nil)
+
+	// Posting a tree merges it down to the config keys.
+	var configs map[string]map[string]string
+	s.do(t, "GET", subject, "", &configs)
+	if configs["basic"]["name"] != "s1" || configs["annotations"]["role"] != "spine" {
+		t.Fatalf("unexpected configs: %v", configs)
+	}
+
+	if status := s.do(t, "POST", "/network/configuration/devices", `{"of:0000000000000001":"invalid"}`, nil); status != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", status)
+	}
+
+	s.do(t, "DELETE", subject+"/basic", "", nil)
+	s.do(t, "DELETE", subject+"/annotations", "", nil)
+	// Subjects and classes left empty are removed.
+	var tree map[string]any
+	s.do(t, "GET", "/network/configuration", "", &tree)
+	if len(tree) != 0 {
+		t.Fatalf("expected an empty configuration, got: %v", tree)
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	s := newTestServer(t)
+
+	request := func(auth func(*http.Request)) int {
+		req, err := http.NewRequest("GET", s.url+"/system", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		auth(req)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+	basic := func(req *http.Request) { req.SetBasicAuth("onos", "rocks") }
+	bearer := func(req *http.Request) { req.Header.Set("Authorization", "Bearer secret") }
+
+	if status := request(func(*http.Request) {}); status != http.StatusUnauthorized {
+		t.Errorf("expected anonymous requests to be rejected, got status %d", status)
+	}
+	if status := request(basic); status != http.StatusOK {
+		t.Errorf("expected the basic credentials to be accepted, got status %d", status)
+	}
+
+	s.SetToken("secret")
+	if status := request(bearer); status != http.StatusOK {
+		t.Errorf("expected the token to be accepted, got status %d", status)
+	}
+	if status := request(basic); status != http.StatusUnauthorized {
+		t.Errorf("expected the basic credentials to be rejected, got status %d", status)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	s := newTestServer(t)
+
+	s.InjectFault(Fault{Method: "GET", Path: "/hosts", Status: http.StatusServiceUnavailable, Times: 2})
+	s.InjectFault(Fault{Path: "/system", Status: http.StatusUnauthorized})
+
+	for i, want := range []int{503, 503, 200} {
+		if status := s.do(t, "GET", "/hosts", "", nil); status != want {
+			t.Fatalf("request %d: expected status %d, got %d", i, want, status)
+		}
+	}
+	if status := s.do(t, "DELETE", "/hosts/00:00:00:00:00:01/None", "", nil); status != http.StatusNoContent {
+		t.Fatalf("expected DELETE to be unaffected, got status %d", status)
+	}
+	if status := s.do(t, "GET", "/system", "", nil); status != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", status)
+	}
+
+	s.ClearFaults()
+	if status := s.do(t, "GET", "/system", "", nil); status != http.StatusOK {
+		t.Fatalf("expected the faults to be cleared, got status %d", status)
+	}
+}
+
+func TestServerLatency(t *testing.T) {
+	s := newTestServer(t)
+	s.InjectFault(Fault{Path: "/system", Latency: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", s.url+"/system", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(DefaultUsername, DefaultPassword)
+
+	res, err := http.DefaultClient.Do(req)
+	if err == nil {
+		res.Body.Close()
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to time out, got: %v", err)
+	}
+}
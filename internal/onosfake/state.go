@@ -0,0 +1,141 @@
+package onosfake
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+//go:embed fixtures/*.json
+var embeddedFixtures embed.FS
+
+// Fixtures is the default fixture set of New, the Mininet topology of
+// examples/docker-compose.yaml: three switches and four hosts.
+var Fixtures = mustSub(embeddedFixtures, "fixtures")
+
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// object is an ONOS API object, kept as decoded from JSON so the responses
+// hold every field of the fixtures and requests.
+type object = map[string]any
+
+// state holds the objects served by the server.
+type state struct {
+	system       object
+	hosts        []object
+	devices      []object
+	ports        []object
+	links        []object
+	flows        []object
+	groups       []object
+	intents      []object
+	applications []object
+	netcfg       object
+	nextID       int
+}
+
+// loadState reads the fixtures into a new state.
+func loadState(fixtures fs.FS) (*state, error) {
+	st := &state{
+		system: object{},
+		netcfg: object{},
+		// IDs of the objects created through the API, away from the fixture ones.
+		nextID: 0x1000,
+	}
+
+	if err := readFixture(fixtures, "system.json", &st.system); err != nil {
+		return nil, err
+	}
+	if err := readFixture(fixtures, "netcfg.json", &st.netcfg); err != nil {
+		return nil, err
+	}
+
+	lists := []struct {
+		file    string
+		field   string
+		objects *[]object
+	}{
+		{"hosts.json", "hosts", &st.hosts},
+		{"devices.json", "devices", &st.devices},
+		{"ports.json", "ports", &st.ports},
+		{"links.json", "links", &st.links},
+		{"flows.json", "flows", &st.flows},
+		{"groups.json", "groups", &st.groups},
+		{"intents.json", "intents", &st.intents},
+		{"applications.json", "applications", &st.applications},
+	}
+	for _, list := range lists {
+		var fixture map[string][]object
+		if err := readFixture(fixtures, list.file, &fixture); err != nil {
+			return nil, err
+		}
+		*list.objects = fixture[list.field]
+	}
+
+	return st, nil
+}
+
+// readFixture decodes a fixture file into v, leaving v untouched when the
+// file does not exist.
+func readFixture(fixtures fs.FS, name string, v any) error {
+	data, err := fs.ReadFile(fixtures, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("reading fixture %s: %w", name, err)
+	}
+	return nil
+}
+
+// newID returns a new ID of an object created through the API.
+func (st *state) newID() int {
+	st.nextID++
+	return st.nextID
+}
+
+// str returns the string field of an object, or an empty string.
+func str(o object, field string) string {
+	s, _ := o[field].(string)
+	return s
+}
+
+// find returns the index of the first object for which match is true, or -1.
+func find(objects []object, match func(object) bool) int {
+	for i, o := range objects {
+		if match(o) {
+			return i
+		}
+	}
+	return -1
+}
+
+// filter returns the objects for which match is true. It never returns nil,
+// so the lists are encoded as empty JSON arrays.
+func filter(objects []object, match func(object) bool) []object {
+	matched := []object{}
+	for _, o := range objects {
+		if match(o) {
+			matched = append(matched, o)
+		}
+	}
+	return matched
+}
+
+// fieldIs returns a match function of the objects with field set to value.
+func fieldIs(field, value string) func(object) bool {
+	return func(o object) bool {
+		return str(o, field) == value
+	}
+}
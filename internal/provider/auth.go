@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"net/http"
+)
+
+// Authentication modes of the ONOS API requests.
+const (
+	authModeBasic  = "basic"
+	authModeBearer = "bearer"
+	authModeNone   = "none"
+)
+
+var authModes = []string{authModeBasic, authModeBearer, authModeNone}
+
+// authOptions holds the authentication settings of the requests to the ONOS
+// API. The basic credentials are held by the onosclient.Client.
+type authOptions struct {
+	Mode    string
+	Token   string
+	Headers map[string]string
+}
+
+// configureAuth wraps the transport of httpClient to authenticate the requests
+// with the options. Both onosclient and onosRequest set basic credentials on
+// every request, so other modes replace them in the transport.
+func configureAuth(httpClient *http.Client, opts authOptions) {
+	if opts.Mode == authModeBasic && len(opts.Headers) == 0 {
+		return
+	}
+
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &authTransport{
+		next: next,
+		opts: opts,
+	}
+}
+
+// authTransport sets the authentication and custom headers of the requests.
+// Custom headers are set last, so they can also replace the Authorization
+// header expected by a reverse proxy.
+type authTransport struct {
+	next http.RoundTripper
+	opts authOptions
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+
+	switch t.opts.Mode {
+	case authModeBearer:
+		req.Header.Set("Authorization", "Bearer "+t.opts.Token)
+	case authModeNone:
+		req.Header.Del("Authorization")
+	}
+	for name, value := range t.opts.Headers {
+		req.Header.Set(name, value)
+	}
+
+	return t.next.RoundTrip(req)
+}
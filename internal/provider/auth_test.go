@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConfigureAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        authOptions
+		wantAuth    string
+		wantHeaders map[string]string
+	}{
+		{
+			name: "basic",
+			opts: authOptions{Mode: authModeBasic},
+			// base64 of onos:rocks
+			wantAuth: "Basic b25vczpyb2Nrcw==",
+		},
+		{
+			name:     "bearer",
+			opts:     authOptions{Mode: authModeBearer, Token: "secret"},
+			wantAuth: "Bearer secret",
+		},
+		{
+			name:     "none",
+			opts:     authOptions{Mode: authModeNone},
+			wantAuth: "",
+		},
+		{
+			name:        "custom headers",
+			opts:        authOptions{Mode: authModeNone, Headers: map[string]string{"X-Forwarded-User": "terraform"}},
+			wantHeaders: map[string]string{"X-Forwarded-User": "terraform"},
+		},
+		{
+			name:     "custom authorization header",
+			opts:     authOptions{Mode: authModeBearer, Token: "secret", Headers: map[string]string{"Authorization": "Proxy secret"}},
+			wantAuth: "Proxy secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got http.Header
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			client, err := onosclient.NewClient(server.URL+"/onos/v1", "onos", "rocks")
+			if err != nil {
+				t.Fatal(err)
+			}
+			configureAuth(client.HTTPClient, tt.opts)
+
+			if err := onosRequest(context.Background(), client, "GET", "/test", nil, nil); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tt.wantHeaders == nil && got.Get("Authorization") != tt.wantAuth {
+				t.Errorf("expected Authorization %q, got %q", tt.wantAuth, got.Get("Authorization"))
+			}
+			for name, value := range tt.wantHeaders {
+				if got.Get(name) != value {
+					t.Errorf("expected %s %q, got %q", name, value, got.Get(name))
+				}
+			}
+		})
+	}
+}
+
+func TestAuthOptionsFromConfig(t *testing.T) {
+	tokenFile := writeTestFile(t, "token", "file-secret\n")
+
+	tests := []struct {
+		name      string
+		env       map[string]string
+		config    onosProviderModel
+		wantMode  string
+		wantToken string
+		wantErr   string
+	}{
+		{
+			name:     "default mode",
+			wantMode: authModeBasic,
+		},
+		{
+			name:      "token from the environment",
+			env:       map[string]string{"ONOS_AUTH_MODE": "bearer", "ONOS_TOKEN": "env-secret"},
+			wantMode:  authModeBearer,
+			wantToken: "env-secret",
+		},
+		{
+			name:      "token file",
+			config:    onosProviderModel{AuthMode: types.StringValue("bearer"), TokenFile: types.StringValue(tokenFile)},
+			wantMode:  authModeBearer,
+			wantToken: "file-secret",
+		},
+		{
+			name:      "configured token replaces the environment token file",
+			env:       map[string]string{"ONOS_TOKEN_FILE": tokenFile},
+			config:    onosProviderModel{AuthMode: types.StringValue("bearer"), Token: types.StringValue("config-secret")},
+			wantMode:  authModeBearer,
+			wantToken: "config-secret",
+		},
+		{
+			name:    "missing token file",
+			config:  onosProviderModel{AuthMode: types.StringValue("bearer"), TokenFile: types.StringValue(tokenFile + ".missing")},
+			wantErr: "Unable to Read onos API Token File",
+		},
+		{
+			name:    "invalid mode in the environment",
+			env:     map[string]string{"ONOS_AUTH_MODE": "oauth"},
+			wantErr: "Invalid ONOS_AUTH_MODE Environment Variable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"ONOS_AUTH_MODE", "ONOS_TOKEN", "ONOS_TOKEN_FILE"} {
+				t.Setenv(name, tt.env[name])
+			}
+			if tt.config.CustomHeaders.IsNull() {
+				tt.config.CustomHeaders = types.MapNull(types.StringType)
+			}
+
+			opts, diags := authOptionsFromConfig(context.Background(), tt.config)
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags.Errors()[0].Summary(), tt.wantErr) {
+					t.Fatalf("expected error %q, got: %v", tt.wantErr, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if opts.Mode != tt.wantMode || opts.Token != tt.wantToken {
+				t.Fatalf("expected mode %q and token %q, got %q and %q", tt.wantMode, tt.wantToken, opts.Mode, opts.Token)
+			}
+		})
+	}
+}
+
+func TestAuthOptionsFromConfigHeaders(t *testing.T) {
+	t.Setenv("ONOS_AUTH_MODE", "")
+
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"X-Forwarded-User": types.StringValue("terraform"),
+	})
+	opts, diags := authOptionsFromConfig(context.Background(), onosProviderModel{
+		AuthMode:      types.StringValue("none"),
+		CustomHeaders: headers,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if opts.Headers["X-Forwarded-User"] != "terraform" {
+		t.Fatalf("expected the custom header, got: %v", opts.Headers)
+	}
+}
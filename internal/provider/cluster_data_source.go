@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterDataSource{}
+)
+
+// NewClusterDataSource is a helper function to simplify the provider implementation.
+func NewClusterDataSource() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+// clusterDataSource is the data source implementation.
+type clusterDataSource struct {
+	client *onosclient.Client
+}
+
+type clusterDataSourceModel struct {
+	LocalNode types.String       `tfsdk:"local_node"`
+	Nodes     []clusterNodeModel `tfsdk:"nodes"`
+}
+
+type clusterNodeModel struct {
+	ID          types.String `tfsdk:"id"`
+	IP          types.String `tfsdk:"ip"`
+	TCPPort     types.Int64  `tfsdk:"tcp_port"`
+	Status      types.String `tfsdk:"status"`
+	LastUpdated types.Int64  `tfsdk:"last_updated"`
+}
+
+// onosCluster maps the ONOS cluster response.
+type onosCluster struct {
+	Nodes []onosClusterNode `json:"nodes"`
+}
+
+// onosClusterNode maps an ONOS controller node. ONOS encodes lastUpdate as a
+// string, so it is decoded as a json.Number.
+type onosClusterNode struct {
+	ID         string      `json:"id"`
+	IP         string      `json:"ip"`
+	TCPPort    int64       `json:"tcpPort"`
+	Status     string      `json:"status"`
+	LastUpdate json.Number `json:"lastUpdate"`
+}
+
+// onosSystem maps the ONOS system information response.
+type onosSystem struct {
+	Node      string `json:"node"`
+	Version   string `json:"version"`
+	ClusterID string `json:"clusterId"`
+	Nodes     int64  `json:"nodes"`
+	Devices   int64  `json:"devices"`
+	Links     int64  `json:"links"`
+	Hosts     int64  `json:"hosts"`
+	SCCs      int64  `json:"sccs"`
+	Flows     int64  `json:"flows"`
+	Intents   int64  `json:"intents"`
+}
+
+// Metadata returns the data source type name.
+func (d *clusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+// Schema defines the schema for the data source.
+func (d *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the controller nodes of the ONOS cluster.",
+		Attributes: map[string]schema.Attribute{
+			"local_node": schema.StringAttribute{
+				Description: "ID of the node the provider is connected to.",
+				Computed:    true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "Controller nodes of the cluster.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the node.",
+							Computed:    true,
+						},
+						"ip": schema.StringAttribute{
+							Description: "IP address of the node.",
+							Computed:    true,
+						},
+						"tcp_port": schema.Int64Attribute{
+							Description: "Cluster communication TCP port of the node.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the node, e.g. READY, ACTIVE or INACTIVE.",
+							Computed:    true,
+						},
+						"last_updated": schema.Int64Attribute{
+							Description: "Time of the last status change of the node in milliseconds since the epoch.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *clusterDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clusterDataSourceModel
+
+	var cluster onosCluster
+	err := onosRequest(ctx, d.client, "GET", "/cluster", nil, &cluster)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	var local onosClusterNode
+	err = onosRequest(ctx, d.client, "GET", "/cluster/local", nil, &local)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Local Cluster Node",
+			err.Error(),
+		)
+		return
+	}
+
+	state.LocalNode = types.StringValue(local.ID)
+	state.Nodes = []clusterNodeModel{}
+	for _, node := range cluster.Nodes {
+		nodeState, err := flattenClusterNode(node)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Onos Cluster Node",
+				err.Error(),
+			)
+			return
+		}
+		state.Nodes = append(state.Nodes, nodeState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flattenClusterNode returns the model of a cluster node read from ONOS. A
+// missing lastUpdate leaves last_updated null.
+func flattenClusterNode(node onosClusterNode) (clusterNodeModel, error) {
+	m := clusterNodeModel{
+		ID:          types.StringValue(node.ID),
+		IP:          types.StringValue(node.IP),
+		TCPPort:     types.Int64Value(node.TCPPort),
+		Status:      types.StringValue(node.Status),
+		LastUpdated: types.Int64Null(),
+	}
+	if node.LastUpdate != "" {
+		lastUpdate, err := node.LastUpdate.Int64()
+		if err != nil {
+			return m, fmt.Errorf("node %s has an invalid lastUpdate %q: %w", node.ID, node.LastUpdate, err)
+		}
+		m.LastUpdated = types.Int64Value(lastUpdate)
+	}
+	return m, nil
+}
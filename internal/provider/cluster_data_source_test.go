@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "onos_cluster" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the single node of the docker compose environment
+					resource.TestCheckResourceAttr("data.onos_cluster.test", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.onos_cluster.test", "nodes.0.status", "READY"),
+					resource.TestCheckResourceAttr("data.onos_cluster.test", "nodes.0.tcp_port", "9876"),
+					resource.TestCheckResourceAttrSet("data.onos_cluster.test", "nodes.0.id"),
+					resource.TestCheckResourceAttrSet("data.onos_cluster.test", "nodes.0.ip"),
+					resource.TestCheckResourceAttrPair("data.onos_cluster.test", "local_node", "data.onos_cluster.test", "nodes.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenClusterNode(t *testing.T) {
+	tests := []struct {
+		name       string
+		lastUpdate json.Number
+		expected   types.Int64
+		wantError  bool
+	}{
+		{name: "last update", lastUpdate: "1700000000000", expected: types.Int64Value(1700000000000)},
+		{name: "no last update", expected: types.Int64Null()},
+		{name: "invalid last update", lastUpdate: "yesterday", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := onosClusterNode{ID: "172.17.0.2", IP: "172.17.0.2", TCPPort: 9876, Status: "READY", LastUpdate: tt.lastUpdate}
+			got, err := flattenClusterNode(node)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("expected error %t, got %v", tt.wantError, err)
+			}
+			if err != nil {
+				return
+			}
+			if !got.LastUpdated.Equal(tt.expected) {
+				t.Errorf("expected last_updated %s, got %s", tt.expected, got.LastUpdated)
+			}
+			if got.ID.ValueString() != node.ID || got.TCPPort.ValueInt64() != node.TCPPort || got.Status.ValueString() != node.Status {
+				t.Errorf("expected the node fields to be copied, got %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &componentConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &componentConfigDataSource{}
+)
+
+// NewComponentConfigDataSource is a helper function to simplify the provider implementation.
+func NewComponentConfigDataSource() datasource.DataSource {
+	return &componentConfigDataSource{}
+}
+
+// componentConfigDataSource is the data source implementation.
+type componentConfigDataSource struct {
+	client *onosclient.Client
+}
+
+type componentConfigDataSourceModel struct {
+	Component  types.String                            `tfsdk:"component"`
+	Properties map[string]componentConfigPropertyModel `tfsdk:"properties"`
+}
+
+type componentConfigPropertyModel struct {
+	Type         types.String `tfsdk:"type"`
+	Value        types.String `tfsdk:"value"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Description  types.String `tfsdk:"description"`
+}
+
+// Metadata returns the data source type name.
+func (d *componentConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *componentConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the properties of an OSGi component.",
+		Attributes: map[string]schema.Attribute{
+			"component": schema.StringAttribute{
+				Description: "Name of the component, e.g. org.onosproject.fwd.ReactiveForwarding.",
+				Required:    true,
+			},
+			"properties": schema.MapNestedAttribute{
+				Description: "Properties of the component by property name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the property, e.g. boolean, integer or string.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Current value of the property.",
+							Computed:    true,
+						},
+						"default_value": schema.StringAttribute{
+							Description: "Default value of the property.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the property.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *componentConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *componentConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state componentConfigDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties, err := getComponentConfig(ctx, d.client, state.Component.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Component Config",
+			"Could not read component "+state.Component.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Properties = map[string]componentConfigPropertyModel{}
+	for name, property := range properties {
+		state.Properties[name] = componentConfigPropertyModel{
+			Type:         types.StringValue(property.Type),
+			Value:        types.StringValue(property.Value),
+			DefaultValue: types.StringValue(property.DefaultValue),
+			Description:  types.StringValue(property.Description),
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
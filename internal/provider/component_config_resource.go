@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &componentConfigResource{}
+	_ resource.ResourceWithConfigure = &componentConfigResource{}
+)
+
+// componentConfigResource is the resource implementation.
+type componentConfigResource struct {
+	client *onosclient.Client
+}
+
+// NewComponentConfigResource is a helper function to simplify the provider implementation.
+func NewComponentConfigResource() resource.Resource {
+	return &componentConfigResource{}
+}
+
+type componentConfigResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Component   types.String `tfsdk:"component"`
+	Properties  types.Map    `tfsdk:"properties"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// onosConfigProperty maps a property of an ONOS component configuration.
+type onosConfigProperty struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Value        string `json:"value"`
+	DefaultValue string `json:"defaultValue"`
+	Description  string `json:"description"`
+}
+
+// Metadata returns the resource type name.
+func (r *componentConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *componentConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages properties of an OSGi component. Only the listed properties are managed; " +
+			"they are restored to their defaults when removed from the configuration or when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of the component.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"component": schema.StringAttribute{
+				Description: "Name of the component, e.g. org.onosproject.fwd.ReactiveForwarding.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				Description: "Property values by property name.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the component config.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *componentConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *componentConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan componentConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties := map[string]string{}
+	resp.Diagnostics.Append(plan.Properties.ElementsAs(ctx, &properties, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", componentConfigPath(plan.Component.ValueString()), properties, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting component config",
+			"Could not set properties of component "+plan.Component.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Component
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *componentConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state componentConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := getComponentConfig(ctx, r.client, state.Component.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Component Config",
+			"Could not read component "+state.Component.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only refresh the properties managed by this resource
+	properties := map[string]string{}
+	resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &properties, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for name := range properties {
+		property, ok := current[name]
+		if !ok {
+			delete(properties, name)
+			continue
+		}
+		properties[name] = property.Value
+	}
+
+	state.Properties, diags = types.MapValueFrom(ctx, types.StringType, properties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update sets the planned properties and restores the defaults of the
+// properties no longer listed.
+func (r *componentConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state componentConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := map[string]string{}
+	previous := map[string]string{}
+	resp.Diagnostics.Append(plan.Properties.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	component := plan.Component.ValueString()
+
+	removed := map[string]string{}
+	for name, value := range previous {
+		if _, ok := planned[name]; !ok {
+			removed[name] = value
+		}
+	}
+	if len(removed) > 0 {
+		err := onosRequest(ctx, r.client, "DELETE", componentConfigPath(component), removed, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Onos Component Config",
+				"Could not restore removed properties of component "+component+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err := onosRequest(ctx, r.client, "POST", componentConfigPath(component), planned, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos Component Config",
+			"Could not set properties of component "+component+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Component
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete restores the managed properties to their defaults.
+func (r *componentConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state componentConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties := map[string]string{}
+	resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &properties, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "DELETE", componentConfigPath(state.Component.ValueString()), properties, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos component config",
+			"Could not restore default properties, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func componentConfigPath(component string) string {
+	return "/configuration/" + url.PathEscape(component)
+}
+
+// getComponentConfig returns the properties of a component by name.
+func getComponentConfig(ctx context.Context, client *onosclient.Client, component string) (map[string]onosConfigProperty, error) {
+	var config map[string]map[string]onosConfigProperty
+	err := onosRequest(ctx, client, "GET", componentConfigPath(component), nil, &config)
+	if err != nil {
+		return nil, err
+	}
+	properties, ok := config[component]
+	if !ok {
+		return nil, &onosAPIError{StatusCode: 404, Body: "component " + component + " not found"}
+	}
+	return properties, nil
+}
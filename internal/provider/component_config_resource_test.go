@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComponentConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_component_config" "test" {
+					component = "org.onosproject.fwd.ReactiveForwarding"
+					properties = {
+						matchIpv4Address = "true"
+					}
+				  }
+
+				data "onos_component_config" "test" {
+					component = onos_component_config.test.component
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_component_config.test", "id", "org.onosproject.fwd.ReactiveForwarding"),
+					resource.TestCheckResourceAttr("onos_component_config.test", "properties.%", "1"),
+					resource.TestCheckResourceAttr("onos_component_config.test", "properties.matchIpv4Address", "true"),
+					resource.TestCheckResourceAttrSet("onos_component_config.test", "last_updated"),
+					resource.TestCheckResourceAttr("data.onos_component_config.test", "properties.matchIpv4Address.value", "true"),
+					resource.TestCheckResourceAttr("data.onos_component_config.test", "properties.matchIpv4Address.default_value", "false"),
+					resource.TestCheckResourceAttr("data.onos_component_config.test", "properties.matchIpv4Address.type", "boolean"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_component_config" "test" {
+					component = "org.onosproject.fwd.ReactiveForwarding"
+					properties = {
+						matchVlanId = "true"
+					}
+				  }
+
+				data "onos_component_config" "test" {
+					component = onos_component_config.test.component
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_component_config.test", "properties.%", "1"),
+					resource.TestCheckResourceAttr("onos_component_config.test", "properties.matchVlanId", "true"),
+					// The property removed from the configuration is restored to its default
+					resource.TestCheckResourceAttr("data.onos_component_config.test", "properties.matchIpv4Address.value", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
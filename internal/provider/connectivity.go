@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// minSupportedVersion is the oldest ONOS release the provider is tested with.
+var minSupportedVersion = onosVersion{Major: 2}
+
+// onosVersion is the release of an ONOS controller, e.g. 2.7.0.
+type onosVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+func (v onosVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// known reports whether v is a detected release rather than the zero value.
+func (v onosVersion) known() bool {
+	return v != onosVersion{}
+}
+
+// atLeast reports whether v is the same release as other or a later one.
+func (v onosVersion) atLeast(other onosVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+// parseONOSVersion parses the version reported by ONOS, ignoring any suffix
+// such as -SNAPSHOT or .rc1, e.g. 2.7.1-SNAPSHOT is 2.7.1.
+func parseONOSVersion(s string) (onosVersion, error) {
+	var v onosVersion
+	fields := strings.SplitN(strings.TrimSpace(s), ".", 4)
+	if len(fields) < 2 {
+		return v, fmt.Errorf("unexpected ONOS version %q", s)
+	}
+
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, field := range fields[:min(len(fields), 3)] {
+		if end := strings.IndexFunc(field, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+			field = field[:end]
+		}
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			if i < 2 {
+				return v, fmt.Errorf("unexpected ONOS version %q", s)
+			}
+			break
+		}
+		*numbers[i] = n
+	}
+	return v, nil
+}
+
+// onosFeature is an API whose availability or format differs between ONOS
+// releases.
+type onosFeature struct {
+	Name string
+	// MinVersion is the oldest ONOS release the provider supports the
+	// feature with.
+	MinVersion onosVersion
+}
+
+var (
+	featureDeltaPortStatistics = onosFeature{Name: "The delta port statistics API", MinVersion: onosVersion{Major: 2}}
+	featurePacketProcessors    = onosFeature{Name: "The packet processors API", MinVersion: onosVersion{Major: 2, Minor: 1}}
+	featureXconnect            = onosFeature{Name: "The segment routing xconnect API", MinVersion: onosVersion{Major: 2, Minor: 2}}
+)
+
+// checkFeature returns an error when the detected ONOS version is older than
+// the feature requires. An unknown version is assumed to support it.
+func checkFeature(version onosVersion, feature onosFeature) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if version.known() && !version.atLeast(feature.MinVersion) {
+		diags.AddError(
+			"Unsupported ONOS Version",
+			fmt.Sprintf("%s requires ONOS %s or later, the provider is connected to ONOS %s.", feature.Name, feature.MinVersion, version),
+		)
+	}
+	return diags
+}
+
+// checkConnectivity reads the system information of the ONOS API to verify
+// the connection settings and credentials, and returns the detected ONOS
+// version. Failures are returned as diagnostics pointing at the likely
+// misconfiguration.
+func checkConnectivity(ctx context.Context, client *onosclient.Client, authMode string) (onosVersion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var system onosSystem
+	err := onosRequest(ctx, client, "GET", "/system", nil, &system)
+	if err != nil {
+		summary, detail := connectivityError(err, client.HostURL, authMode)
+		diags.AddError(summary, detail+"\n\nSet skip_connectivity_check to true to skip this check.\n\nError: "+err.Error())
+		return onosVersion{}, diags
+	}
+
+	version, err := parseONOSVersion(system.Version)
+	if err != nil {
+		diags.AddWarning(
+			"Unknown ONOS Version",
+			"The provider cannot detect the ONOS version, so it does not check the release required by the resources and data sources: "+err.Error(),
+		)
+		return onosVersion{}, diags
+	}
+
+	if !version.atLeast(minSupportedVersion) {
+		diags.AddAttributeWarning(
+			path.Root("host"),
+			"Unsupported ONOS Version",
+			fmt.Sprintf("ONOS %s is older than %s, the oldest release supported by the provider. Some resources and data sources may fail.", version, minSupportedVersion),
+		)
+	}
+
+	return version, diags
+}
+
+// connectivityError returns the summary and detail of the diagnostic of a
+// failed connectivity check.
+func connectivityError(err error, host, authMode string) (string, string) {
+	var dnsErr *net.DNSError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certificateErr x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+	var syntaxErr *json.SyntaxError
+	var opErr *net.OpError
+
+	switch {
+	case errors.As(err, &dnsErr):
+		return "Unable to Resolve ONOS Host",
+			fmt.Sprintf("The provider cannot resolve the address of %s. Check the host value or the ONOS_HOST environment variable.", host)
+	case errors.As(err, &unknownAuthorityErr):
+		return "Untrusted ONOS API Certificate",
+			fmt.Sprintf("The certificate of %s is not signed by a trusted CA. Set ca_cert_file or ca_cert_pem to the CA of the ONOS API certificate.", host)
+	case errors.As(err, &hostnameErr):
+		return "ONOS API Certificate Name Mismatch",
+			fmt.Sprintf("The certificate of %s is not valid for its host name. Set tls_server_name to a name of the certificate.", host)
+	case errors.As(err, &certificateErr):
+		return "Invalid ONOS API Certificate",
+			fmt.Sprintf("The certificate of %s is invalid, e.g. expired.", host)
+	case errors.As(err, &recordHeaderErr),
+		strings.Contains(err.Error(), "server gave HTTP response to HTTPS client"),
+		strings.Contains(err.Error(), "HTTP request to an HTTPS server"):
+		return "ONOS API Protocol Mismatch",
+			fmt.Sprintf("%s does not answer with the protocol of its scheme. Check that the host uses https:// for a TLS endpoint and http:// otherwise.", host)
+	case errors.As(err, &opErr):
+		return "Unable to Connect to ONOS",
+			fmt.Sprintf("The provider cannot connect to %s. Check that ONOS is running and reachable from this machine.", host)
+	case errors.As(err, &syntaxErr):
+		return "Unexpected ONOS API Response",
+			fmt.Sprintf("%s does not answer like the ONOS API. Check that the host ends with the API base path, e.g. http://localhost:8181/onos/v1.", host)
+	}
+
+	var apiErr *onosAPIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			detail := "The ONOS API rejected the credentials. "
+			switch authMode {
+			case authModeBearer:
+				detail += "Check the token, token_file or ONOS_TOKEN value."
+			case authModeNone:
+				detail += "auth_mode is none, set auth_mode and credentials if the ONOS API requires authentication."
+			default:
+				detail += "Check the username and password values or the ONOS_USERNAME and ONOS_PASSWORD environment variables."
+			}
+			return "ONOS Authentication Failed", detail
+		case http.StatusNotFound:
+			return "ONOS API Not Found",
+				fmt.Sprintf("%s has no ONOS API. Check that the host ends with the API base path, e.g. http://localhost:8181/onos/v1.", host)
+		}
+	}
+
+	return "Unable to Reach ONOS API",
+		fmt.Sprintf("The connectivity check of %s failed.", host)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+)
+
+func TestParseONOSVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    onosVersion
+		wantErr bool
+	}{
+		{version: "2.7.0", want: onosVersion{2, 7, 0}},
+		{version: "2.7.1-SNAPSHOT", want: onosVersion{2, 7, 1}},
+		{version: "2.5.0.rc1", want: onosVersion{2, 5, 0}},
+		{version: "1.15", want: onosVersion{1, 15, 0}},
+		{version: "3.0.0-b2", want: onosVersion{3, 0, 0}},
+		{version: "", wantErr: true},
+		{version: "unknown", wantErr: true},
+		{version: "v2.x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseONOSVersion(tt.version)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseONOSVersion(%q) = %s, want error", tt.version, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("parseONOSVersion(%q) = %s, %v, want %s", tt.version, got, err, tt.want)
+		}
+	}
+}
+
+func TestONOSVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version onosVersion
+		other   onosVersion
+		want    bool
+	}{
+		{onosVersion{2, 7, 0}, onosVersion{2, 7, 0}, true},
+		{onosVersion{2, 7, 1}, onosVersion{2, 7, 0}, true},
+		{onosVersion{2, 10, 0}, onosVersion{2, 7, 3}, true},
+		{onosVersion{3, 0, 0}, onosVersion{2, 7, 0}, true},
+		{onosVersion{2, 6, 9}, onosVersion{2, 7, 0}, false},
+		{onosVersion{1, 15, 0}, onosVersion{2, 0, 0}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.version.atLeast(tt.other); got != tt.want {
+			t.Errorf("%s.atLeast(%s) = %t, want %t", tt.version, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestCheckFeature(t *testing.T) {
+	feature := onosFeature{Name: "The test API", MinVersion: onosVersion{2, 2, 0}}
+	tests := []struct {
+		name      string
+		version   onosVersion
+		wantError bool
+	}{
+		{name: "same release", version: onosVersion{2, 2, 0}},
+		{name: "later release", version: onosVersion{2, 7, 0}},
+		{name: "unknown release", version: onosVersion{}},
+		{name: "older release", version: onosVersion{2, 1, 3}, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkFeature(tt.version, feature)
+			if diags.HasError() != tt.wantError {
+				t.Errorf("expected error %t, got %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestCheckConnectivity(t *testing.T) {
+	respond := func(status int, body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}
+	}
+	tlsServer := newTestTLSServer(t, nil)
+
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		host        string
+		authMode    string
+		tls         tlsOptions
+		wantVersion onosVersion
+		wantSummary string
+	}{
+		{
+			name:        "supported version",
+			handler:     respond(http.StatusOK, `{"version":"2.7.0","nodes":1}`),
+			wantVersion: onosVersion{2, 7, 0},
+		},
+		{
+			name:        "unsupported version",
+			handler:     respond(http.StatusOK, `{"version":"1.15.0"}`),
+			wantVersion: onosVersion{1, 15, 0},
+			wantSummary: "Unsupported ONOS Version",
+		},
+		{
+			name:        "unknown version",
+			handler:     respond(http.StatusOK, `{}`),
+			wantVersion: onosVersion{},
+			wantSummary: "Unknown ONOS Version",
+		},
+		{
+			name:        "wrong password",
+			handler:     respond(http.StatusUnauthorized, ``),
+			wantSummary: "ONOS Authentication Failed",
+		},
+		{
+			name:        "wrong token",
+			handler:     respond(http.StatusUnauthorized, ``),
+			authMode:    authModeBearer,
+			wantSummary: "ONOS Authentication Failed",
+		},
+		{
+			name:        "wrong base path",
+			handler:     respond(http.StatusNotFound, ``),
+			wantSummary: "ONOS API Not Found",
+		},
+		{
+			name:        "not the ONOS API",
+			handler:     respond(http.StatusOK, `<html></html>`),
+			wantSummary: "Unexpected ONOS API Response",
+		},
+		{
+			name:        "connection refused",
+			host:        newDownNode(t),
+			wantSummary: "Unable to Connect to ONOS",
+		},
+		{
+			name:        "unresolvable host",
+			host:        "http://onos.invalid:8181/onos/v1",
+			wantSummary: "Unable to Resolve ONOS Host",
+		},
+		{
+			name:        "untrusted certificate",
+			host:        tlsServer.URL + "/onos/v1",
+			wantSummary: "Untrusted ONOS API Certificate",
+		},
+		{
+			name:        "certificate name mismatch",
+			host:        tlsServer.URL + "/onos/v1",
+			tls:         tlsOptions{CACertPEM: serverCAPEM(tlsServer), ServerName: "onos.example.org"},
+			wantSummary: "ONOS API Certificate Name Mismatch",
+		},
+		{
+			name:        "HTTP to a TLS endpoint",
+			host:        "http://" + tlsServer.Listener.Addr().String() + "/onos/v1",
+			wantSummary: "ONOS API Protocol Mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := tt.host
+			if tt.handler != nil {
+				server := httptest.NewServer(tt.handler)
+				defer server.Close()
+				host = server.URL + "/onos/v1"
+			}
+			client, err := onosclient.NewClient(host, "onos", "rocks")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := configureTLS(client.HTTPClient, tt.tls); err != nil {
+				t.Fatal(err)
+			}
+
+			version, diags := checkConnectivity(context.Background(), client, tt.authMode)
+			if tt.wantSummary == "" {
+				if len(diags) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+			} else if len(diags) != 1 || diags[0].Summary() != tt.wantSummary {
+				t.Fatalf("expected diagnostic %q, got: %v", tt.wantSummary, diags)
+			}
+			if !diags.HasError() && version != tt.wantVersion {
+				t.Fatalf("expected version %s, got %s", tt.wantVersion, version)
+			}
+		})
+	}
+}
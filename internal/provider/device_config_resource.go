@@ -0,0 +1,419 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceConfigResource{}
+	_ resource.ResourceWithConfigure   = &deviceConfigResource{}
+	_ resource.ResourceWithImportState = &deviceConfigResource{}
+)
+
+// deviceConfigResource is the resource implementation.
+type deviceConfigResource struct {
+	client *onosclient.Client
+}
+
+// NewDeviceConfigResource is a helper function to simplify the provider implementation.
+func NewDeviceConfigResource() resource.Resource {
+	return &deviceConfigResource{}
+}
+
+type deviceConfigResourceModel struct {
+	ID                types.String         `tfsdk:"id"`
+	DeviceID          types.String         `tfsdk:"device_id"`
+	Name              types.String         `tfsdk:"name"`
+	Driver            types.String         `tfsdk:"driver"`
+	ManagementAddress types.String         `tfsdk:"management_address"`
+	Pipeconf          types.String         `tfsdk:"pipeconf"`
+	Location          *deviceLocationModel `tfsdk:"location"`
+	RackAddress       types.String         `tfsdk:"rack_address"`
+	Owner             types.String         `tfsdk:"owner"`
+	Annotations       types.Map            `tfsdk:"annotations"`
+	LastUpdated       types.String         `tfsdk:"last_updated"`
+}
+
+type deviceLocationModel struct {
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+	GridX     types.Float64 `tfsdk:"grid_x"`
+	GridY     types.Float64 `tfsdk:"grid_y"`
+}
+
+// onosDeviceBasicConfig maps the basic config key of the devices netcfg
+// subject.
+type onosDeviceBasicConfig struct {
+	Name              string   `json:"name,omitempty"`
+	Driver            string   `json:"driver,omitempty"`
+	ManagementAddress string   `json:"managementAddress,omitempty"`
+	Pipeconf          string   `json:"pipeconf,omitempty"`
+	LocType           string   `json:"locType,omitempty"`
+	Latitude          *float64 `json:"latitude,omitempty"`
+	Longitude         *float64 `json:"longitude,omitempty"`
+	GridX             *float64 `json:"gridX,omitempty"`
+	GridY             *float64 `json:"gridY,omitempty"`
+	RackAddress       string   `json:"rackAddress,omitempty"`
+	Owner             string   `json:"owner,omitempty"`
+}
+
+// onosAnnotationsConfig maps the annotations config key of the devices
+// netcfg subject.
+type onosAnnotationsConfig struct {
+	Entries map[string]string `json:"entries"`
+}
+
+// Metadata returns the resource type name.
+func (r *deviceConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the basic and annotations network configuration of a device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the device.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device to configure.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Friendly name of the device.",
+				Optional:    true,
+			},
+			"driver": schema.StringAttribute{
+				Description: "Driver used for the device.",
+				Optional:    true,
+			},
+			"management_address": schema.StringAttribute{
+				Description: "Management address of the device, e.g. grpc://10.0.0.1:50001?device_id=1.",
+				Optional:    true,
+			},
+			"pipeconf": schema.StringAttribute{
+				Description: "ID of the pipeconf used for the device.",
+				Optional:    true,
+			},
+			"location": schema.SingleNestedAttribute{
+				Description: "Location of the device, given either as latitude/longitude or as grid_x/grid_y.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"latitude": schema.Float64Attribute{
+						Description: "Geographic latitude of the device.",
+						Optional:    true,
+						Validators:  locationValidators("longitude", "grid_x", "grid_y"),
+					},
+					"longitude": schema.Float64Attribute{
+						Description: "Geographic longitude of the device.",
+						Optional:    true,
+						Validators:  locationValidators("latitude", "grid_x", "grid_y"),
+					},
+					"grid_x": schema.Float64Attribute{
+						Description: "X coordinate of the device on the grid layout.",
+						Optional:    true,
+						Validators:  locationValidators("grid_y", "latitude", "longitude"),
+					},
+					"grid_y": schema.Float64Attribute{
+						Description: "Y coordinate of the device on the grid layout.",
+						Optional:    true,
+						Validators:  locationValidators("grid_x", "latitude", "longitude"),
+					},
+				},
+			},
+			"rack_address": schema.StringAttribute{
+				Description: "Rack address of the device.",
+				Optional:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Owner of the device.",
+				Optional:    true,
+			},
+			"annotations": schema.MapAttribute{
+				Description: "Arbitrary annotations of the device.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the device config.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// locationValidators requires the paired coordinate and rejects coordinates
+// of the other location type.
+func locationValidators(pair string, conflicts ...string) []validator.Float64 {
+	conflictPaths := []path.Expression{}
+	for _, conflict := range conflicts {
+		conflictPaths = append(conflictPaths, path.MatchRelative().AtParent().AtName(conflict))
+	}
+	return []validator.Float64{
+		float64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(pair)),
+		float64validator.ConflictsWith(conflictPaths...),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deviceConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deviceConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.DeviceID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deviceConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceID := state.DeviceID.ValueString()
+
+	var basic onosDeviceBasicConfig
+	basicErr := onosRequest(ctx, r.client, "GET", netcfgPath("devices", deviceID, "basic"), nil, &basic)
+	if basicErr != nil && !isNotFound(basicErr) {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Device Config",
+			"Could not read basic config of device "+deviceID+": "+basicErr.Error(),
+		)
+		return
+	}
+
+	var annotations onosAnnotationsConfig
+	annotationsErr := onosRequest(ctx, r.client, "GET", netcfgPath("devices", deviceID, "annotations"), nil, &annotations)
+	if annotationsErr != nil && !isNotFound(annotationsErr) {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Device Config",
+			"Could not read annotations of device "+deviceID+": "+annotationsErr.Error(),
+		)
+		return
+	}
+
+	// The config was removed outside of Terraform
+	if isNotFound(basicErr) && isNotFound(annotationsErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenDeviceBasicConfig(basic, &state)
+
+	state.Annotations = types.MapNull(types.StringType)
+	if len(annotations.Entries) > 0 {
+		state.Annotations, diags = types.MapValueFrom(ctx, types.StringType, annotations.Entries)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deviceConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.DeviceID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deviceConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, configKey := range []string{"basic", "annotations"} {
+		err := onosRequest(ctx, r.client, "DELETE", netcfgPath("devices", state.DeviceID.ValueString(), configKey), nil, nil)
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting onos device config",
+				"Could not delete "+configKey+" config, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *deviceConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID)...)
+}
+
+// apply posts the basic config and replaces or removes the annotations.
+func (r *deviceConfigResource) apply(ctx context.Context, plan deviceConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	deviceID := plan.DeviceID.ValueString()
+
+	err := onosRequest(ctx, r.client, "POST", netcfgPath("devices", deviceID, "basic"), expandDeviceBasicConfig(plan), nil)
+	if err != nil {
+		diags.AddError(
+			"Error applying device config",
+			"Could not apply basic config of device "+deviceID+", unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if plan.Annotations.IsNull() {
+		err = onosRequest(ctx, r.client, "DELETE", netcfgPath("devices", deviceID, "annotations"), nil, nil)
+		if err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Error applying device config",
+				"Could not remove annotations of device "+deviceID+", unexpected error: "+err.Error(),
+			)
+		}
+		return diags
+	}
+
+	annotations := onosAnnotationsConfig{}
+	diags.Append(plan.Annotations.ElementsAs(ctx, &annotations.Entries, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	err = onosRequest(ctx, r.client, "POST", netcfgPath("devices", deviceID, "annotations"), annotations, nil)
+	if err != nil {
+		diags.AddError(
+			"Error applying device config",
+			"Could not apply annotations of device "+deviceID+", unexpected error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// expandDeviceBasicConfig builds the basic netcfg JSON from the model.
+func expandDeviceBasicConfig(m deviceConfigResourceModel) onosDeviceBasicConfig {
+	basic := onosDeviceBasicConfig{
+		Name:              m.Name.ValueString(),
+		Driver:            m.Driver.ValueString(),
+		ManagementAddress: m.ManagementAddress.ValueString(),
+		Pipeconf:          m.Pipeconf.ValueString(),
+		RackAddress:       m.RackAddress.ValueString(),
+		Owner:             m.Owner.ValueString(),
+	}
+	if m.Location != nil {
+		if !m.Location.Latitude.IsNull() {
+			basic.LocType = "geo"
+			basic.Latitude = m.Location.Latitude.ValueFloat64Pointer()
+			basic.Longitude = m.Location.Longitude.ValueFloat64Pointer()
+		} else if !m.Location.GridX.IsNull() {
+			basic.LocType = "grid"
+			basic.GridX = m.Location.GridX.ValueFloat64Pointer()
+			basic.GridY = m.Location.GridY.ValueFloat64Pointer()
+		}
+	}
+	return basic
+}
+
+// flattenDeviceBasicConfig copies the basic netcfg into the model, leaving
+// attributes ONOS does not report as null.
+func flattenDeviceBasicConfig(basic onosDeviceBasicConfig, m *deviceConfigResourceModel) {
+	m.Name = optionalString(basic.Name)
+	m.Driver = optionalString(basic.Driver)
+	m.ManagementAddress = optionalString(basic.ManagementAddress)
+	m.Pipeconf = optionalString(basic.Pipeconf)
+	m.RackAddress = optionalString(basic.RackAddress)
+	m.Owner = optionalString(basic.Owner)
+
+	m.Location = nil
+	switch {
+	case basic.Latitude != nil || basic.Longitude != nil:
+		m.Location = &deviceLocationModel{
+			Latitude:  types.Float64PointerValue(basic.Latitude),
+			Longitude: types.Float64PointerValue(basic.Longitude),
+			GridX:     types.Float64Null(),
+			GridY:     types.Float64Null(),
+		}
+	case basic.GridX != nil || basic.GridY != nil:
+		m.Location = &deviceLocationModel{
+			Latitude:  types.Float64Null(),
+			Longitude: types.Float64Null(),
+			GridX:     types.Float64PointerValue(basic.GridX),
+			GridY:     types.Float64PointerValue(basic.GridY),
+		}
+	}
+}
+
+// optionalString maps an empty string from ONOS to a null attribute value.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
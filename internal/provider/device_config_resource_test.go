@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_device_config" "test" {
+					device_id = "of:0000000000000001"
+					name      = "s1"
+					location = {
+					  grid_x = 100
+					  grid_y = 200
+					}
+					annotations = {
+					  role = "spine"
+					}
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_device_config.test", "id", "of:0000000000000001"),
+					resource.TestCheckResourceAttr("onos_device_config.test", "name", "s1"),
+					resource.TestCheckResourceAttr("onos_device_config.test", "location.grid_x", "100"),
+					resource.TestCheckResourceAttr("onos_device_config.test", "location.grid_y", "200"),
+					resource.TestCheckResourceAttr("onos_device_config.test", "annotations.role", "spine"),
+					resource.TestCheckResourceAttrSet("onos_device_config.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_device_config.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_device_config" "test" {
+					device_id = "of:0000000000000001"
+					name      = "spine1"
+					owner     = "fabric"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_device_config.test", "name", "spine1"),
+					resource.TestCheckResourceAttr("onos_device_config.test", "owner", "fabric"),
+					resource.TestCheckNoResourceAttr("onos_device_config.test", "location"),
+					resource.TestCheckNoResourceAttr("onos_device_config.test", "annotations"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &devicePortStateResource{}
+	_ resource.ResourceWithConfigure   = &devicePortStateResource{}
+	_ resource.ResourceWithImportState = &devicePortStateResource{}
+)
+
+// devicePortStateResource is the resource implementation.
+type devicePortStateResource struct {
+	client *onosclient.Client
+}
+
+// NewDevicePortStateResource is a helper function to simplify the provider implementation.
+func NewDevicePortStateResource() resource.Resource {
+	return &devicePortStateResource{}
+}
+
+type devicePortStateResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	DeviceID         types.String `tfsdk:"device_id"`
+	Port             types.String `tfsdk:"port"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+	Type             types.String `tfsdk:"type"`
+	PortSpeed        types.Int64  `tfsdk:"port_speed"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+}
+
+// onosDevicePorts maps the ONOS device ports response.
+type onosDevicePorts struct {
+	ID    string           `json:"id"`
+	Ports []onosDevicePort `json:"ports"`
+}
+
+// onosDevicePort maps a port of an ONOS device.
+type onosDevicePort struct {
+	Element   string `json:"element"`
+	Port      string `json:"port"`
+	IsEnabled bool   `json:"isEnabled"`
+	Type      string `json:"type"`
+	PortSpeed int64  `json:"portSpeed"`
+}
+
+// Metadata returns the resource type name.
+func (r *devicePortStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_port_state"
+}
+
+// Schema defines the schema for the resource.
+func (r *devicePortStateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the administrative state of a device port.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the port in the form device_id/port.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device the port belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.StringAttribute{
+				Description: "Number of the port.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the port is administratively enabled.",
+				Required:    true,
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Description: "Administrative state the port is set to when the resource is destroyed. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the port, e.g. COPPER or FIBER.",
+				Computed:    true,
+			},
+			"port_speed": schema.Int64Attribute{
+				Description: "Speed of the port in Mbps.",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the port state.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *devicePortStateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *devicePortStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan devicePortStateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, applied, err := setPortState(ctx, r.client, plan.DeviceID.ValueString(), plan.Port.ValueString(), plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting port state",
+			"Could not set port state, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	enabled := plan.Enabled
+	flattenDevicePort(port, &plan)
+	if !applied {
+		resp.Diagnostics.AddWarning(
+			"Port State Not Yet Applied",
+			fmt.Sprintf("ONOS accepted the state of port %s but did not report it within %s. "+
+				"The state records the planned value, the next refresh reads the port again.", plan.ID.ValueString(), portStateTimeout),
+		)
+		plan.Enabled = enabled
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *devicePortStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state devicePortStateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, found, err := getDevicePort(ctx, r.client, state.DeviceID.ValueString(), state.Port.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Port State",
+			"Could not read port "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenDevicePort(port, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *devicePortStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan devicePortStateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, applied, err := setPortState(ctx, r.client, plan.DeviceID.ValueString(), plan.Port.ValueString(), plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos Port State",
+			"Could not set port state, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	enabled := plan.Enabled
+	flattenDevicePort(port, &plan)
+	if !applied {
+		resp.Diagnostics.AddWarning(
+			"Port State Not Yet Applied",
+			fmt.Sprintf("ONOS accepted the state of port %s but did not report it within %s. "+
+				"The state records the planned value, the next refresh reads the port again.", plan.ID.ValueString(), portStateTimeout),
+		)
+		plan.Enabled = enabled
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete reverts the port to the restore_on_destroy state.
+func (r *devicePortStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state devicePortStateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", portStatePath(state.DeviceID.ValueString(), state.Port.ValueString()),
+		map[string]bool{"enabled": state.RestoreOnDestroy.ValueBool()}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos port state",
+			"Could not restore port state, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *devicePortStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Pass in the device id and port, e.g. terraform import onos_device_port_state.uplink "of:0000000000000001/2"
+	idx := strings.LastIndex(req.ID, "/")
+	if idx <= 0 || idx == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: DeviceID/Port. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID[:idx])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), req.ID[idx+1:])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
+}
+
+// portStateTimeout bounds the wait for a port to report a state change, and
+// portStatePollInterval is the delay between its reads.
+var (
+	portStateTimeout      = 5 * time.Second
+	portStatePollInterval = 250 * time.Millisecond
+)
+
+func portStatePath(deviceID, port string) string {
+	return "/devices/" + url.PathEscape(deviceID) + "/portstate/" + url.PathEscape(port)
+}
+
+// setPortState changes the administrative state of the port and returns the
+// port as reported by ONOS afterwards. The change is applied asynchronously,
+// so it reports whether the port showed the new state within portStateTimeout.
+func setPortState(ctx context.Context, client *onosclient.Client, deviceID, port string, enabled bool) (onosDevicePort, bool, error) {
+	err := onosRequest(ctx, client, "POST", portStatePath(deviceID, port), map[string]bool{"enabled": enabled}, nil)
+	if err != nil {
+		return onosDevicePort{}, false, err
+	}
+
+	ticker := time.NewTicker(portStatePollInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(portStateTimeout)
+	defer timeout.Stop()
+	for {
+		devicePort, found, err := getDevicePort(ctx, client, deviceID, port)
+		if err != nil {
+			return devicePort, false, err
+		}
+		if !found {
+			return devicePort, false, fmt.Errorf("port %s not found on device %s", port, deviceID)
+		}
+		if devicePort.IsEnabled == enabled {
+			return devicePort, true, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return devicePort, false, ctx.Err()
+		case <-timeout.C:
+			return devicePort, false, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// getDevicePort looks up a single port of a device.
+func getDevicePort(ctx context.Context, client *onosclient.Client, deviceID, port string) (onosDevicePort, bool, error) {
+	var ports onosDevicePorts
+	err := onosRequest(ctx, client, "GET", "/devices/"+url.PathEscape(deviceID)+"/ports", nil, &ports)
+	if err != nil {
+		return onosDevicePort{}, false, err
+	}
+	for _, p := range ports.Ports {
+		if p.Port == port {
+			return p, true, nil
+		}
+	}
+	return onosDevicePort{}, false, nil
+}
+
+// flattenDevicePort copies the ONOS port into the resource model.
+func flattenDevicePort(port onosDevicePort, m *devicePortStateResourceModel) {
+	m.ID = types.StringValue(m.DeviceID.ValueString() + "/" + port.Port)
+	m.Port = types.StringValue(port.Port)
+	m.Enabled = types.BoolValue(port.IsEnabled)
+	m.Type = types.StringValue(port.Type)
+	m.PortSpeed = types.Int64Value(port.PortSpeed)
+}
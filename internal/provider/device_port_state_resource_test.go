@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-onos/internal/onosfake"
+)
+
+func TestAccDevicePortStateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_device_port_state" "test" {
+					device_id = "of:0000000000000002"
+					port      = "1"
+					enabled   = false
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_device_port_state.test", "id", "of:0000000000000002/1"),
+					resource.TestCheckResourceAttr("onos_device_port_state.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("onos_device_port_state.test", "restore_on_destroy", "true"),
+					resource.TestCheckResourceAttrSet("onos_device_port_state.test", "type"),
+					resource.TestCheckResourceAttrSet("onos_device_port_state.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_device_port_state.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_device_port_state" "test" {
+					device_id = "of:0000000000000002"
+					port      = "1"
+					enabled   = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_device_port_state.test", "enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestSetPortState(t *testing.T) {
+	fake, client := newTestFakeClient(t)
+	timeout, pollInterval := portStateTimeout, portStatePollInterval
+	portStateTimeout, portStatePollInterval = 100*time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() { portStateTimeout, portStatePollInterval = timeout, pollInterval })
+
+	port, applied, err := setPortState(context.Background(), client, "of:0000000000000002", "1", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !applied || port.IsEnabled {
+		t.Errorf("expected the port to be disabled, got applied=%t enabled=%t", applied, port.IsEnabled)
+	}
+
+	// ONOS accepts the change but never applies it.
+	fake.InjectFault(onosfake.Fault{Method: "POST", Path: "/devices", Status: http.StatusOK})
+
+	port, applied, err = setPortState(context.Background(), client, "of:0000000000000002", "1", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if applied || port.IsEnabled {
+		t.Errorf("expected the wait to time out, got applied=%t enabled=%t", applied, port.IsEnabled)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = setPortState(ctx, client, "of:0000000000000002", "1", true)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,405 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceResource{}
+	_ resource.ResourceWithConfigure   = &deviceResource{}
+	_ resource.ResourceWithImportState = &deviceResource{}
+)
+
+// deviceResource is the resource implementation.
+type deviceResource struct {
+	client *onosclient.Client
+}
+
+// NewDeviceResource is a helper function to simplify the provider implementation.
+func NewDeviceResource() resource.Resource {
+	return &deviceResource{}
+}
+
+type deviceResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	DeviceID     types.String `tfsdk:"device_id"`
+	PurgeFlows   types.Bool   `tfsdk:"purge_flows"`
+	PurgeGroups  types.Bool   `tfsdk:"purge_groups"`
+	PurgeHosts   types.Bool   `tfsdk:"purge_hosts"`
+	Type         types.String `tfsdk:"type"`
+	Available    types.Bool   `tfsdk:"available"`
+	Role         types.String `tfsdk:"role"`
+	Manufacturer types.String `tfsdk:"manufacturer"`
+	HwVersion    types.String `tfsdk:"hw_version"`
+	SwVersion    types.String `tfsdk:"sw_version"`
+	Serial       types.String `tfsdk:"serial"`
+	Driver       types.String `tfsdk:"driver"`
+	ChassisID    types.String `tfsdk:"chassis_id"`
+}
+
+// onosDevices maps the ONOS device list response.
+type onosDevices struct {
+	Devices []onosDevice `json:"devices"`
+}
+
+// onosDevice maps an ONOS device.
+type onosDevice struct {
+	ID          string            `json:"id"`
+	Type        string            `json:"type"`
+	Available   bool              `json:"available"`
+	Role        string            `json:"role"`
+	Mfr         string            `json:"mfr"`
+	Hw          string            `json:"hw"`
+	Sw          string            `json:"sw"`
+	Serial      string            `json:"serial"`
+	Driver      string            `json:"driver"`
+	ChassisID   string            `json:"chassisId"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// onosDeviceFlows maps the ONOS flows of a device; only the fields needed to
+// remove them are decoded.
+type onosDeviceFlows struct {
+	Flows []struct {
+		ID string `json:"id"`
+	} `json:"flows"`
+}
+
+// onosDeviceGroups maps the ONOS groups of a device; only the fields needed
+// to remove them are decoded.
+type onosDeviceGroups struct {
+	Groups []struct {
+		ID        string `json:"id"`
+		AppCookie string `json:"appCookie"`
+	} `json:"groups"`
+}
+
+// Metadata returns the resource type name.
+func (r *deviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Represents the administrative presence of a device discovered by ONOS. " +
+			"Creating the resource only adopts an existing device; destroying it removes the device from ONOS, " +
+			"optionally purging its flows, groups and attached hosts first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the device.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device, e.g. of:0000000000000001.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"purge_flows": schema.BoolAttribute{
+				Description: "Remove the flows of the device before removing it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"purge_groups": schema.BoolAttribute{
+				Description: "Remove the groups of the device before removing it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"purge_hosts": schema.BoolAttribute{
+				Description: "Remove the hosts attached to the device before removing it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the device, e.g. SWITCH.",
+				Computed:    true,
+			},
+			"available": schema.BoolAttribute{
+				Description: "Whether the device is available.",
+				Computed:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Mastership role of the local node for the device.",
+				Computed:    true,
+			},
+			"manufacturer": schema.StringAttribute{
+				Description: "Manufacturer of the device.",
+				Computed:    true,
+			},
+			"hw_version": schema.StringAttribute{
+				Description: "Hardware version of the device.",
+				Computed:    true,
+			},
+			"sw_version": schema.StringAttribute{
+				Description: "Software version of the device.",
+				Computed:    true,
+			},
+			"serial": schema.StringAttribute{
+				Description: "Serial number of the device.",
+				Computed:    true,
+			},
+			"driver": schema.StringAttribute{
+				Description: "Driver used for the device.",
+				Computed:    true,
+			},
+			"chassis_id": schema.StringAttribute{
+				Description: "Chassis ID of the device.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deviceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create adopts the existing device into the Terraform state.
+func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := getDevice(ctx, r.client, plan.DeviceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adopting device",
+			"Could not find device "+plan.DeviceID.ValueString()+" in ONOS. Devices are discovered by ONOS and cannot be created by Terraform: "+err.Error(),
+		)
+		return
+	}
+
+	flattenDevice(device, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := getDevice(ctx, r.client, state.DeviceID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Device",
+			"Could not read device "+state.DeviceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	flattenDevice(device, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes the purge options, which are used on destroy.
+func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state deviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.PurgeFlows = plan.PurgeFlows
+	state.PurgeGroups = plan.PurgeGroups
+	state.PurgeHosts = plan.PurgeHosts
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete purges the requested objects and removes the device. Flows and
+// groups go first so they are not re-installed on the device, then the hosts
+// so they do not point at a missing location, and finally the device itself.
+func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceID := state.DeviceID.ValueString()
+	ctx = tflog.SetField(ctx, "onos_device_id", deviceID)
+
+	steps := []struct {
+		enabled bool
+		name    string
+		summary string
+		purge   func(context.Context, *onosclient.Client, string) (int, error)
+	}{
+		{state.PurgeFlows.ValueBool(), "flows", "Error Purging Device Flows", purgeDeviceFlows},
+		{state.PurgeGroups.ValueBool(), "groups", "Error Purging Device Groups", purgeDeviceGroups},
+		{state.PurgeHosts.ValueBool(), "hosts", "Error Purging Device Hosts", purgeDeviceHosts},
+	}
+	for _, step := range steps {
+		if !step.enabled {
+			continue
+		}
+		count, err := step.purge(ctx, r.client, deviceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				step.summary,
+				fmt.Sprintf("Could not remove the %s of device %s after removing %d of them; the device was not removed: %s", step.name, deviceID, count, err.Error()),
+			)
+			return
+		}
+		tflog.Info(ctx, "Purged device "+step.name, map[string]any{"count": count})
+	}
+
+	err := onosRequest(ctx, r.client, "DELETE", "/devices/"+url.PathEscape(deviceID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos device",
+			"Could not remove device "+deviceID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "Removed device")
+}
+
+func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("purge_flows"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("purge_groups"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("purge_hosts"), false)...)
+}
+
+func getDevice(ctx context.Context, client *onosclient.Client, deviceID string) (onosDevice, error) {
+	var device onosDevice
+	err := onosRequest(ctx, client, "GET", "/devices/"+url.PathEscape(deviceID), nil, &device)
+	return device, err
+}
+
+// purgeDeviceFlows removes all flows of the device and returns how many were
+// removed.
+func purgeDeviceFlows(ctx context.Context, client *onosclient.Client, deviceID string) (int, error) {
+	var flows onosDeviceFlows
+	err := onosRequest(ctx, client, "GET", "/flows/"+url.PathEscape(deviceID), nil, &flows)
+	if err != nil {
+		return 0, err
+	}
+	for i, flow := range flows.Flows {
+		err = onosRequest(ctx, client, "DELETE", "/flows/"+url.PathEscape(deviceID)+"/"+url.PathEscape(flow.ID), nil, nil)
+		if err != nil && !isNotFound(err) {
+			return i, err
+		}
+	}
+	return len(flows.Flows), nil
+}
+
+// purgeDeviceGroups removes all groups of the device and returns how many
+// were removed.
+func purgeDeviceGroups(ctx context.Context, client *onosclient.Client, deviceID string) (int, error) {
+	var groups onosDeviceGroups
+	err := onosRequest(ctx, client, "GET", "/groups/"+url.PathEscape(deviceID), nil, &groups)
+	if err != nil {
+		return 0, err
+	}
+	for i, group := range groups.Groups {
+		err = onosRequest(ctx, client, "DELETE", "/groups/"+url.PathEscape(deviceID)+"/"+url.PathEscape(group.AppCookie), nil, nil)
+		if err != nil && !isNotFound(err) {
+			return i, err
+		}
+	}
+	return len(groups.Groups), nil
+}
+
+// purgeDeviceHosts removes all hosts located on the device and returns how
+// many were removed.
+func purgeDeviceHosts(ctx context.Context, client *onosclient.Client, deviceID string) (int, error) {
+	hosts, err := client.GetHosts()
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, host := range hosts.Hosts {
+		if !hostAttachedTo(host, deviceID) {
+			continue
+		}
+		err = onosRequest(ctx, client, "DELETE", "/hosts/"+url.PathEscape(host.Mac)+"/"+url.PathEscape(host.Vlan), nil, nil)
+		if err != nil && !isNotFound(err) {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// hostAttachedTo reports whether any location of the host is on the device.
+func hostAttachedTo(host onosclient.Host, deviceID string) bool {
+	for _, location := range host.Locations {
+		if location.ElementID == deviceID {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenDevice copies the ONOS device into the resource model.
+func flattenDevice(device onosDevice, m *deviceResourceModel) {
+	m.ID = types.StringValue(device.ID)
+	m.DeviceID = types.StringValue(device.ID)
+	m.Type = types.StringValue(device.Type)
+	m.Available = types.BoolValue(device.Available)
+	m.Role = types.StringValue(device.Role)
+	m.Manufacturer = types.StringValue(device.Mfr)
+	m.HwVersion = types.StringValue(device.Hw)
+	m.SwVersion = types.StringValue(device.Sw)
+	m.Serial = types.StringValue(device.Serial)
+	m.Driver = types.StringValue(device.Driver)
+	m.ChassisID = types.StringValue(device.ChassisID)
+}
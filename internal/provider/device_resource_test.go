@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_device" "test" {
+					device_id   = "of:0000000000000003"
+					purge_hosts = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_device.test", "id", "of:0000000000000003"),
+					resource.TestCheckResourceAttr("onos_device.test", "purge_flows", "false"),
+					resource.TestCheckResourceAttr("onos_device.test", "purge_hosts", "true"),
+					resource.TestCheckResourceAttr("onos_device.test", "available", "true"),
+					resource.TestCheckResourceAttr("onos_device.test", "type", "SWITCH"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_device.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"purge_hosts"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
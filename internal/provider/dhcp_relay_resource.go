@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &dhcpRelayResource{}
+	_ resource.ResourceWithConfigure        = &dhcpRelayResource{}
+	_ resource.ResourceWithConfigValidators = &dhcpRelayResource{}
+	_ resource.ResourceWithImportState      = &dhcpRelayResource{}
+)
+
+// dhcpRelayApp is the netcfg subject of the DHCP relay app.
+const dhcpRelayApp = "org.onosproject.dhcprelay"
+
+// dhcpRelayResource is the resource implementation.
+type dhcpRelayResource struct {
+	client *onosclient.Client
+}
+
+// NewDhcpRelayResource is a helper function to simplify the provider implementation.
+func NewDhcpRelayResource() resource.Resource {
+	return &dhcpRelayResource{}
+}
+
+type dhcpRelayResourceModel struct {
+	ID              types.String      `tfsdk:"id"`
+	DefaultServers  []dhcpServerModel `tfsdk:"default_servers"`
+	IndirectServers []dhcpServerModel `tfsdk:"indirect_servers"`
+	LastUpdated     types.String      `tfsdk:"last_updated"`
+}
+
+type dhcpServerModel struct {
+	ConnectPoint types.String `tfsdk:"connect_point"`
+	ServerIPs    types.List   `tfsdk:"server_ips"`
+	GatewayIPs   types.List   `tfsdk:"gateway_ips"`
+}
+
+// onosDhcpServer maps a DHCP server entry of the DHCP relay app config.
+type onosDhcpServer struct {
+	DhcpServerConnectPoint string   `json:"dhcpServerConnectPoint"`
+	ServerIPs              []string `json:"serverIps"`
+	GatewayIPs             []string `json:"gatewayIps,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *dhcpRelayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_relay"
+}
+
+// Schema defines the schema for the resource.
+func (r *dhcpRelayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	serverAttributes := map[string]schema.Attribute{
+		"connect_point": schema.StringAttribute{
+			Description: "Connect point the DHCP server is reached through, e.g. of:0000000000000002/2.",
+			Required:    true,
+			Validators: []validator.String{
+				connectPointValidator,
+			},
+		},
+		"server_ips": schema.ListAttribute{
+			Description: "IPv4 and IPv6 addresses of the DHCP server.",
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(ipAddressValidator{}),
+			},
+		},
+		"gateway_ips": schema.ListAttribute{
+			Description: "IPv4 and IPv6 addresses of the gateway towards the DHCP server.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(ipAddressValidator{}),
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the DHCP server entries of the ONOS DHCP relay app. Only the default and indirect config keys are " +
+			"written; other keys of the app config are left untouched, as is a server list that is not set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of the DHCP relay app.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_servers": schema.ListNestedAttribute{
+				Description: "DHCP servers for directly connected hosts.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverAttributes,
+				},
+			},
+			"indirect_servers": schema.ListNestedAttribute{
+				Description: "DHCP servers for hosts behind another relay agent.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverAttributes,
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the DHCP relay config.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *dhcpRelayResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("default_servers"),
+			path.MatchRoot("indirect_servers"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dhcpRelayResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dhcpRelayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dhcpRelayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, dhcpRelayResourceModel{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(dhcpRelayApp)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dhcpRelayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dhcpRelayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After an import nothing is managed yet, so read both server lists
+	importing := state.DefaultServers == nil && state.IndirectServers == nil
+
+	found := false
+	for _, list := range []struct {
+		configKey string
+		servers   *[]dhcpServerModel
+	}{
+		{"default", &state.DefaultServers},
+		{"indirect", &state.IndirectServers},
+	} {
+		if *list.servers == nil && !importing {
+			continue
+		}
+
+		var servers []onosDhcpServer
+		err := onosRequest(ctx, r.client, "GET", netcfgPath("apps", dhcpRelayApp, list.configKey), nil, &servers)
+		if isNotFound(err) {
+			*list.servers = nil
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Onos DHCP Relay Config",
+				"Could not read "+list.configKey+" DHCP servers: "+err.Error(),
+			)
+			return
+		}
+
+		*list.servers, diags = flattenDhcpServers(ctx, servers)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		found = true
+	}
+
+	// The config was removed outside of Terraform
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dhcpRelayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dhcpRelayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(dhcpRelayApp)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the managed server lists.
+func (r *dhcpRelayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dhcpRelayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, dhcpRelayResourceModel{}, state)...)
+}
+
+func (r *dhcpRelayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the planned server lists and deletes the lists that are no
+// longer planned but were managed before.
+func (r *dhcpRelayResource) apply(ctx context.Context, plan, previous dhcpRelayResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, list := range []struct {
+		configKey string
+		planned   []dhcpServerModel
+		managed   bool
+	}{
+		{"default", plan.DefaultServers, previous.DefaultServers != nil},
+		{"indirect", plan.IndirectServers, previous.IndirectServers != nil},
+	} {
+		configPath := netcfgPath("apps", dhcpRelayApp, list.configKey)
+
+		if list.planned == nil {
+			if !list.managed {
+				continue
+			}
+			err := onosRequest(ctx, r.client, "DELETE", configPath, nil, nil)
+			if err != nil && !isNotFound(err) {
+				diags.AddError(
+					"Error applying DHCP relay config",
+					"Could not remove "+list.configKey+" DHCP servers, unexpected error: "+err.Error(),
+				)
+				return diags
+			}
+			continue
+		}
+
+		servers, d := expandDhcpServers(ctx, list.planned)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		err := onosRequest(ctx, r.client, "POST", configPath, servers, nil)
+		if err != nil {
+			diags.AddError(
+				"Error applying DHCP relay config",
+				"Could not apply "+list.configKey+" DHCP servers, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	return diags
+}
+
+// expandDhcpServers builds the server list netcfg JSON from the model.
+func expandDhcpServers(ctx context.Context, models []dhcpServerModel) ([]onosDhcpServer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	servers := []onosDhcpServer{}
+	for _, m := range models {
+		server := onosDhcpServer{DhcpServerConnectPoint: m.ConnectPoint.ValueString()}
+		diags.Append(m.ServerIPs.ElementsAs(ctx, &server.ServerIPs, false)...)
+		if !m.GatewayIPs.IsNull() {
+			diags.Append(m.GatewayIPs.ElementsAs(ctx, &server.GatewayIPs, false)...)
+		}
+		servers = append(servers, server)
+	}
+	return servers, diags
+}
+
+// flattenDhcpServers converts the server list netcfg into the model.
+func flattenDhcpServers(ctx context.Context, servers []onosDhcpServer) ([]dhcpServerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	models := []dhcpServerModel{}
+	for _, server := range servers {
+		m := dhcpServerModel{
+			ConnectPoint: types.StringValue(server.DhcpServerConnectPoint),
+			GatewayIPs:   types.ListNull(types.StringType),
+		}
+		var d diag.Diagnostics
+		m.ServerIPs, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(server.ServerIPs))
+		diags.Append(d...)
+		if len(server.GatewayIPs) > 0 {
+			m.GatewayIPs, d = types.ListValueFrom(ctx, types.StringType, server.GatewayIPs)
+			diags.Append(d...)
+		}
+		models = append(models, m)
+	}
+	return models, diags
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDhcpRelayResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "onos_dhcp_relay" "test" {
+					default_servers = [
+						{
+							connect_point = "of:0000000000000002"
+							server_ips    = ["10.0.3.252"]
+						},
+					]
+				  }
+`,
+				ExpectError: regexp.MustCompile(`must be a connect point`),
+			},
+			{
+				Config: providerConfig + `
+				resource "onos_dhcp_relay" "test" {
+					default_servers = [
+						{
+							connect_point = "of:0000000000000002/2"
+							server_ips    = ["10.0.3"]
+						},
+					]
+				  }
+`,
+				ExpectError: regexp.MustCompile(`must be an IP address`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_dhcp_relay" "test" {
+					default_servers = [
+						{
+							connect_point = "of:0000000000000002/2"
+							server_ips    = ["10.0.3.252", "2000::3:252"]
+						},
+					]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_dhcp_relay.test", "id", "org.onosproject.dhcprelay"),
+					resource.TestCheckResourceAttr("onos_dhcp_relay.test", "default_servers.#", "1"),
+					resource.TestCheckResourceAttr("onos_dhcp_relay.test", "default_servers.0.server_ips.#", "2"),
+					resource.TestCheckNoResourceAttr("onos_dhcp_relay.test", "indirect_servers"),
+					resource.TestCheckResourceAttrSet("onos_dhcp_relay.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_dhcp_relay.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_dhcp_relay" "test" {
+					default_servers = [
+						{
+							connect_point = "of:0000000000000002/2"
+							server_ips    = ["10.0.3.252"]
+							gateway_ips   = ["10.0.1.254"]
+						},
+					]
+					indirect_servers = [
+						{
+							connect_point = "of:0000000000000002/3"
+							server_ips    = ["10.0.4.252"]
+						},
+					]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_dhcp_relay.test", "default_servers.0.gateway_ips.0", "10.0.1.254"),
+					resource.TestCheckResourceAttr("onos_dhcp_relay.test", "indirect_servers.0.connect_point", "of:0000000000000002/3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
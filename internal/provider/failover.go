@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// parseNodes parses the API URLs of the nodes of an ONOS cluster. Requests
+// are failed over by swapping the scheme and address of their URL, so all
+// the nodes must serve the API under the same path.
+func parseNodes(hosts []string) ([]*url.URL, error) {
+	nodes := make([]*url.URL, 0, len(hosts))
+	for _, host := range hosts {
+		node, err := url.Parse(host)
+		if err != nil {
+			return nil, err
+		}
+		if node.Scheme == "" || node.Host == "" {
+			return nil, fmt.Errorf("%q is not an absolute URL such as http://onos1:8181/onos/v1", host)
+		}
+		if len(nodes) > 0 && strings.TrimSuffix(node.Path, "/") != strings.TrimSuffix(nodes[0].Path, "/") {
+			return nil, fmt.Errorf("%q does not have the API path of %q, all the hosts must only differ by scheme, address and port", host, hosts[0])
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// selectNode returns the index of the first node of the cluster answering an
+// API request. Any HTTP response but a 5xx status means the node is up, the
+// credentials are checked by the later requests.
+func selectNode(ctx context.Context, httpClient *http.Client, nodes []*url.URL) (int, error) {
+	var errs []error
+	for i, node := range nodes {
+		err := probeNode(ctx, httpClient, node)
+		if err == nil {
+			return i, nil
+		}
+		tflog.Warn(ctx, "ONOS node unreachable", map[string]any{"onos_host": node.String(), "error": err.Error()})
+		errs = append(errs, fmt.Errorf("%s: %w", node, err))
+	}
+	return 0, errors.Join(errs...)
+}
+
+func probeNode(ctx context.Context, httpClient *http.Client, node *url.URL) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(node.String(), "/")+"/system", nil)
+	if err != nil {
+		return err
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("status: %d", res.StatusCode)
+	}
+	return nil
+}
+
+// configureFailover wraps the transport of httpClient to send the requests to
+// the current node of the cluster, starting with the node at index current,
+// and to fail over to the next nodes when it cannot be reached.
+func configureFailover(httpClient *http.Client, nodes []*url.URL, current int) {
+	if len(nodes) < 2 {
+		return
+	}
+
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &failoverTransport{
+		next:    next,
+		nodes:   nodes,
+		current: current,
+	}
+}
+
+// failoverTransport sends the requests to the current node of an ONOS cluster
+// and moves to the next nodes in turn on connection errors.
+type failoverTransport struct {
+	next  http.RoundTripper
+	nodes []*url.URL
+
+	mu      sync.Mutex
+	current int
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	t.mu.Lock()
+	start := t.current
+	t.mu.Unlock()
+
+	var err error
+	for i := range t.nodes {
+		n := (start + i) % len(t.nodes)
+		node := t.nodes[n]
+
+		attempt := req.Clone(ctx)
+		attempt.URL.Scheme = node.Scheme
+		attempt.URL.Host = node.Host
+		attempt.Host = ""
+		if i > 0 && req.Body != nil {
+			if req.GetBody == nil {
+				return nil, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			attempt.Body = body
+		}
+
+		var res *http.Response
+		res, err = t.next.RoundTrip(attempt)
+		if err == nil {
+			if n != start {
+				t.mu.Lock()
+				t.current = n
+				t.mu.Unlock()
+				tflog.Info(ctx, "Failed over to ONOS node", map[string]any{"onos_host": node.String()})
+			}
+			return res, nil
+		}
+		if ctx.Err() != nil || !isConnectionError(err) {
+			return nil, err
+		}
+		tflog.Warn(ctx, "ONOS node unreachable", map[string]any{"onos_host": node.String(), "error": err.Error()})
+	}
+	return nil, err
+}
+
+// isConnectionError reports whether err means the node could not be reached
+// or dropped the connection, as opposed to a timeout or cancellation.
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+)
+
+// newTestNode starts an ONOS node stand-in answering every request with
+// status and counting the requests.
+func newTestNode(t *testing.T, status int, hits *atomic.Int64) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.Method == "POST" {
+			if body, _ := io.ReadAll(r.Body); string(body) != `{"key":"value"}` {
+				t.Errorf("unexpected body %q", body)
+			}
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newDownNode returns the API URL of a node that refuses connections.
+func newDownNode(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL + "/onos/v1"
+}
+
+func mustParseNodes(t *testing.T, hosts ...string) []*url.URL {
+	t.Helper()
+
+	nodes, err := parseNodes(hosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nodes
+}
+
+func TestParseNodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		hosts   []string
+		wantErr string
+	}{
+		{
+			name:  "single host",
+			hosts: []string{"http://onos1:8181/onos/v1"},
+		},
+		{
+			name:  "cluster",
+			hosts: []string{"http://onos1:8181/onos/v1", "https://onos2:8443/onos/v1/"},
+		},
+		{
+			name:    "relative URL",
+			hosts:   []string{"onos1:8181/onos/v1"},
+			wantErr: "not an absolute URL",
+		},
+		{
+			name:    "different API paths",
+			hosts:   []string{"http://onos1:8181/onos/v1", "http://onos2:8181/v1"},
+			wantErr: "does not have the API path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseNodes(tt.hosts)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSelectNode(t *testing.T) {
+	var hits atomic.Int64
+	unavailable := newTestNode(t, http.StatusServiceUnavailable, &hits)
+	// Credentials are not checked by the probe, an unauthorized node is up.
+	unauthorized := newTestNode(t, http.StatusUnauthorized, &hits)
+
+	nodes := mustParseNodes(t, newDownNode(t), unavailable.URL+"/onos/v1", unauthorized.URL+"/onos/v1")
+	current, err := selectNode(context.Background(), http.DefaultClient, nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if current != 2 {
+		t.Fatalf("expected node 2 to be selected, got %d", current)
+	}
+
+	_, err = selectNode(context.Background(), http.DefaultClient, nodes[:2])
+	if err == nil || !strings.Contains(err.Error(), "status: 503") {
+		t.Fatalf("expected no node to be selected, got: %v", err)
+	}
+}
+
+func TestFailoverTransport(t *testing.T) {
+	var firstHits, secondHits atomic.Int64
+	first := newTestNode(t, http.StatusOK, &firstHits)
+	second := newTestNode(t, http.StatusOK, &secondHits)
+
+	nodes := mustParseNodes(t, first.URL+"/onos/v1", second.URL+"/onos/v1")
+	client, err := onosclient.NewClient(first.URL+"/onos/v1", "onos", "rocks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	configureFailover(client.HTTPClient, nodes, 0)
+
+	ctx := context.Background()
+	in := map[string]string{"key": "value"}
+	if err := onosRequest(ctx, client, "POST", "/test", in, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	first.Close()
+	if err := onosRequest(ctx, client, "POST", "/test", in, nil); err != nil {
+		t.Fatalf("unexpected error after the first node went down: %s", err)
+	}
+	// The next requests go straight to the node that answered.
+	if err := onosRequest(ctx, client, "GET", "/test", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if firstHits.Load() != 1 || secondHits.Load() != 2 {
+		t.Fatalf("expected 1 request to the first node and 2 to the second, got %d and %d", firstHits.Load(), secondHits.Load())
+	}
+}
+
+func TestFailoverTransportServerError(t *testing.T) {
+	var firstHits, secondHits atomic.Int64
+	first := newTestNode(t, http.StatusInternalServerError, &firstHits)
+	second := newTestNode(t, http.StatusOK, &secondHits)
+
+	nodes := mustParseNodes(t, first.URL+"/onos/v1", second.URL+"/onos/v1")
+	client, err := onosclient.NewClient(first.URL+"/onos/v1", "onos", "rocks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	configureFailover(client.HTTPClient, nodes, 0)
+
+	// A node answering with an error is up, the error is returned as is.
+	err = onosRequest(context.Background(), client, "GET", "/test", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "status: 500") {
+		t.Fatalf("expected the server error, got: %v", err)
+	}
+	if secondHits.Load() != 0 {
+		t.Fatalf("expected no request to the second node, got %d", secondHits.Load())
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The selector and treatment models below are shared by the flow objective
+// resources. Only the attributes relevant to a criterion or instruction type
+// need to be set; null attributes are left out of the ONOS request.
+
+type objectiveSelectorModel struct {
+	Criteria []objectiveCriterionModel `tfsdk:"criteria"`
+}
+
+type objectiveCriterionModel struct {
+	Type     types.String `tfsdk:"type"`
+	Port     types.Int64  `tfsdk:"port"`
+	EthType  types.String `tfsdk:"eth_type"`
+	Mac      types.String `tfsdk:"mac"`
+	VlanID   types.Int64  `tfsdk:"vlan_id"`
+	IP       types.String `tfsdk:"ip"`
+	Protocol types.Int64  `tfsdk:"protocol"`
+	TCPPort  types.Int64  `tfsdk:"tcp_port"`
+	UDPPort  types.Int64  `tfsdk:"udp_port"`
+}
+
+type objectiveTreatmentModel struct {
+	Instructions []objectiveInstructionModel `tfsdk:"instructions"`
+}
+
+type objectiveInstructionModel struct {
+	Type    types.String `tfsdk:"type"`
+	Subtype types.String `tfsdk:"subtype"`
+	Port    types.String `tfsdk:"port"`
+	MeterID types.String `tfsdk:"meter_id"`
+	GroupID types.Int64  `tfsdk:"group_id"`
+	TableID types.Int64  `tfsdk:"table_id"`
+	VlanID  types.Int64  `tfsdk:"vlan_id"`
+	Mac     types.String `tfsdk:"mac"`
+}
+
+func objectiveSelectorAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"criteria": schema.ListNestedAttribute{
+			Description: "Match criteria of the selector.",
+			Required:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of the criterion, e.g. IN_PORT, ETH_TYPE, ETH_DST, VLAN_VID, IPV4_DST, IP_PROTO or TCP_DST.",
+						Required:    true,
+					},
+					"port": schema.Int64Attribute{
+						Description: "Port number for IN_PORT criteria.",
+						Optional:    true,
+					},
+					"eth_type": schema.StringAttribute{
+						Description: "Ethernet type for ETH_TYPE criteria, e.g. 0x0800.",
+						Optional:    true,
+					},
+					"mac": schema.StringAttribute{
+						Description: "MAC address for ETH_SRC and ETH_DST criteria.",
+						Optional:    true,
+					},
+					"vlan_id": schema.Int64Attribute{
+						Description: "VLAN ID for VLAN_VID criteria.",
+						Optional:    true,
+					},
+					"ip": schema.StringAttribute{
+						Description: "IP prefix for IPV4_SRC, IPV4_DST, IPV6_SRC and IPV6_DST criteria.",
+						Optional:    true,
+					},
+					"protocol": schema.Int64Attribute{
+						Description: "IP protocol number for IP_PROTO criteria.",
+						Optional:    true,
+					},
+					"tcp_port": schema.Int64Attribute{
+						Description: "TCP port for TCP_SRC and TCP_DST criteria.",
+						Optional:    true,
+					},
+					"udp_port": schema.Int64Attribute{
+						Description: "UDP port for UDP_SRC and UDP_DST criteria.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func objectiveTreatmentAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"instructions": schema.ListNestedAttribute{
+			Description: "Instructions of the treatment.",
+			Required:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of the instruction, e.g. OUTPUT, METER, GROUP, TABLE, L2MODIFICATION or NOACTION.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("OUTPUT", "METER", "GROUP", "TABLE", "L2MODIFICATION", "L3MODIFICATION", "NOACTION"),
+						},
+					},
+					"subtype": schema.StringAttribute{
+						Description: "Subtype of modification instructions, e.g. VLAN_ID, VLAN_POP or ETH_DST.",
+						Optional:    true,
+					},
+					"port": schema.StringAttribute{
+						Description: "Output port for OUTPUT instructions, e.g. 1 or CONTROLLER.",
+						Optional:    true,
+					},
+					"meter_id": schema.StringAttribute{
+						Description: "Meter ID for METER instructions, e.g. from onos_meter.",
+						Optional:    true,
+					},
+					"group_id": schema.Int64Attribute{
+						Description: "Group ID for GROUP instructions.",
+						Optional:    true,
+					},
+					"table_id": schema.Int64Attribute{
+						Description: "Table ID for TABLE instructions.",
+						Optional:    true,
+					},
+					"vlan_id": schema.Int64Attribute{
+						Description: "VLAN ID for VLAN_ID modifications.",
+						Optional:    true,
+					},
+					"mac": schema.StringAttribute{
+						Description: "MAC address for ETH_SRC and ETH_DST modifications.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandObjectiveSelector builds the ONOS selector JSON from the model.
+func expandObjectiveSelector(m *objectiveSelectorModel) map[string]any {
+	criteria := []map[string]any{}
+	if m != nil {
+		for _, c := range m.Criteria {
+			criterion := map[string]any{"type": c.Type.ValueString()}
+			setIfKnown(criterion, "port", c.Port)
+			setIfKnown(criterion, "ethType", c.EthType)
+			setIfKnown(criterion, "mac", c.Mac)
+			setIfKnown(criterion, "vlanId", c.VlanID)
+			setIfKnown(criterion, "ip", c.IP)
+			setIfKnown(criterion, "protocol", c.Protocol)
+			setIfKnown(criterion, "tcpPort", c.TCPPort)
+			setIfKnown(criterion, "udpPort", c.UDPPort)
+			criteria = append(criteria, criterion)
+		}
+	}
+	return map[string]any{"criteria": criteria}
+}
+
+// expandObjectiveTreatment builds the ONOS treatment JSON from the model.
+func expandObjectiveTreatment(m *objectiveTreatmentModel) map[string]any {
+	instructions := []map[string]any{}
+	if m != nil {
+		for _, i := range m.Instructions {
+			instruction := map[string]any{"type": i.Type.ValueString()}
+			setIfKnown(instruction, "subtype", i.Subtype)
+			setIfKnown(instruction, "port", i.Port)
+			setIfKnown(instruction, "meterId", i.MeterID)
+			setIfKnown(instruction, "groupId", i.GroupID)
+			setIfKnown(instruction, "tableId", i.TableID)
+			setIfKnown(instruction, "vlanId", i.VlanID)
+			setIfKnown(instruction, "mac", i.Mac)
+			instructions = append(instructions, instruction)
+		}
+	}
+	return map[string]any{"instructions": instructions}
+}
+
+// setIfKnown adds the value to the JSON object when it is neither null nor
+// unknown.
+func setIfKnown(obj map[string]any, key string, value any) {
+	switch v := value.(type) {
+	case types.String:
+		if !v.IsNull() && !v.IsUnknown() {
+			obj[key] = v.ValueString()
+		}
+	case types.Int64:
+		if !v.IsNull() && !v.IsUnknown() {
+			obj[key] = v.ValueInt64()
+		}
+	case types.Bool:
+		if !v.IsNull() && !v.IsUnknown() {
+			obj[key] = v.ValueBool()
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &flowTableStatisticsDataSource{}
+	_ datasource.DataSourceWithConfigure = &flowTableStatisticsDataSource{}
+)
+
+// NewFlowTableStatisticsDataSource is a helper function to simplify the provider implementation.
+func NewFlowTableStatisticsDataSource() datasource.DataSource {
+	return &flowTableStatisticsDataSource{}
+}
+
+// flowTableStatisticsDataSource is the data source implementation.
+type flowTableStatisticsDataSource struct {
+	client *onosclient.Client
+}
+
+type flowTableStatisticsDataSourceModel struct {
+	DeviceID types.String               `tfsdk:"device_id"`
+	Tables   []flowTableStatisticsModel `tfsdk:"tables"`
+}
+
+type flowTableStatisticsModel struct {
+	DeviceID        types.String `tfsdk:"device_id"`
+	TableID         types.String `tfsdk:"table_id"`
+	ActiveEntries   types.Int64  `tfsdk:"active_entries"`
+	PacketsLookedUp types.Int64  `tfsdk:"packets_looked_up"`
+	PacketsMatched  types.Int64  `tfsdk:"packets_matched"`
+}
+
+// onosFlowTableStatistics maps the ONOS flow table statistics response.
+type onosFlowTableStatistics struct {
+	Statistics []onosDeviceTableStatistics `json:"statistics"`
+}
+
+// onosDeviceTableStatistics maps the flow table statistics of one device.
+type onosDeviceTableStatistics struct {
+	Device string                   `json:"device"`
+	Table  []onosFlowTableStatistic `json:"table"`
+}
+
+// onosFlowTableStatistic maps the counters of one flow table. P4 pipelines
+// name their tables, so the table ID is kept as raw JSON.
+type onosFlowTableStatistic struct {
+	TableID         json.RawMessage `json:"tableId"`
+	ActiveEntries   int64           `json:"activeEntries"`
+	PacketsLookedUp int64           `json:"packetsLookedUp"`
+	PacketsMatched  int64           `json:"packetsMatched"`
+}
+
+// Metadata returns the data source type name.
+func (d *flowTableStatisticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow_table_statistics"
+}
+
+// Schema defines the schema for the data source.
+func (d *flowTableStatisticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the flow table counters of the devices.",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Description: "Only return the tables of this device.",
+				Optional:    true,
+			},
+			"tables": schema.ListNestedAttribute{
+				Description: "Counters per device flow table.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.StringAttribute{
+							Description: "ID of the device.",
+							Computed:    true,
+						},
+						"table_id": schema.StringAttribute{
+							Description: "ID of the flow table.",
+							Computed:    true,
+						},
+						"active_entries": schema.Int64Attribute{
+							Description: "Number of flow entries in the table.",
+							Computed:    true,
+						},
+						"packets_looked_up": schema.Int64Attribute{
+							Description: "Number of packets looked up in the table.",
+							Computed:    true,
+						},
+						"packets_matched": schema.Int64Attribute{
+							Description: "Number of packets that matched an entry of the table.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *flowTableStatisticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *flowTableStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state flowTableStatisticsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statistics onosFlowTableStatistics
+	err := onosRequest(ctx, d.client, "GET", "/statistics/flows/tables", nil, &statistics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Flow Table Statistics",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Tables = []flowTableStatisticsModel{}
+	for _, device := range statistics.Statistics {
+		if !state.DeviceID.IsNull() && device.Device != state.DeviceID.ValueString() {
+			continue
+		}
+		for _, table := range device.Table {
+			state.Tables = append(state.Tables, flowTableStatisticsModel{
+				DeviceID:        types.StringValue(device.Device),
+				TableID:         types.StringValue(strings.Trim(string(table.TableID), `"`)),
+				ActiveEntries:   types.Int64Value(table.ActiveEntries),
+				PacketsLookedUp: types.Int64Value(table.PacketsLookedUp),
+				PacketsMatched:  types.Int64Value(table.PacketsMatched),
+			})
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
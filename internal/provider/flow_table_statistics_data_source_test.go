@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFlowTableStatisticsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "onos_flow_table_statistics" "test" {
+					device_id = "of:0000000000000001"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onos_flow_table_statistics.test", "tables.0.device_id", "of:0000000000000001"),
+					resource.TestCheckResourceAttr("data.onos_flow_table_statistics.test", "tables.0.table_id", "0"),
+					resource.TestCheckResourceAttrSet("data.onos_flow_table_statistics.test", "tables.0.active_entries"),
+					resource.TestCheckResourceAttrSet("data.onos_flow_table_statistics.test", "tables.0.packets_matched"),
+				),
+			},
+		},
+	})
+}
@@ -232,24 +232,26 @@ func (d *flowsDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*onosclient.Client)
+	data, ok := req.ProviderData.(*onosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *onos.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *flowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state flowsDataSourceModel
+	state := flowsDataSourceModel{
+		Flows: []flowsModel{},
+	}
 	flows, err := d.client.GetFlows()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -260,53 +262,7 @@ func (d *flowsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	for _, flow := range flows.Flow {
-		flowState := flowsModel{
-			AppID:       types.StringValue(flow.AppID),
-			Bytes:       types.Int64Value(int64(flow.Bytes)),
-			DeviceID:    types.StringValue(flow.DeviceID),
-			GroupID:     types.Int64Value(int64(flow.GroupID)),
-			ID:          types.StringValue(flow.ID),
-			IsPermanent: types.BoolValue(flow.IsPermanent),
-			LastSeen:    types.Int64Value(int64(flow.LastSeen)),
-			Life:        types.Int64Value(int64(flow.Life)),
-			LiveType:    types.StringValue(flow.LiveType),
-			Packets:     types.Int64Value(int64(flow.Packets)),
-			Priority:    types.Int64Value(int64(flow.Priority)),
-			State:       types.StringValue(flow.State),
-			TableID:     types.Int64Value(int64(flow.TableID)),
-			TableName:   types.StringValue(flow.TableName),
-			Timeout:     types.Int64Value(int64(flow.Timeout)),
-			Selector: flowsSelectorModel{
-				Criteria: []flowsSelectorCriteriaModel{},
-			},
-			Treatment: flowsTreatmentModel{
-				ClearDeferred: types.BoolValue(flow.Treatment.ClearDeferred),
-				Deferred:      []flowsTreatmentInstructionsModel{},
-				Instructions:  []flowsTreatmentInstructionsModel{},
-			},
-		}
-		for _, criteria := range flow.Selector.Criteria {
-			flowState.Selector.Criteria = append(flowState.Selector.Criteria, flowsSelectorCriteriaModel{
-				EthType: types.StringValue(criteria.EthType),
-				Mac:     types.StringValue(criteria.Mac),
-				Port:    types.Int64Value(int64(criteria.Port)),
-				Type:    types.StringValue(criteria.Type),
-			})
-		}
-
-		for _, instruction := range flow.Treatment.Instructions {
-			flowState.Treatment.Instructions = append(flowState.Treatment.Instructions, flowsTreatmentInstructionsModel{
-				Port: types.StringValue(instruction.Port),
-				Type: types.StringValue(instruction.Type),
-			})
-		}
-		for _, deferred := range flow.Treatment.Deferred {
-			flowState.Treatment.Deferred = append(flowState.Treatment.Deferred, flowsTreatmentInstructionsModel{
-				Port: types.StringValue(deferred.Port),
-				Type: types.StringValue(deferred.Type),
-			})
-		}
-		state.Flows = append(state.Flows, flowState)
+		state.Flows = append(state.Flows, flattenFlow(flow))
 	}
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -314,3 +270,53 @@ func (d *flowsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 }
+
+// flattenFlow returns the model of a flow read from ONOS. Missing criteria and
+// instructions are set to empty lists, so the nested lists are never null.
+func flattenFlow(flow onosclient.Flow) flowsModel {
+	m := flowsModel{
+		AppID:       types.StringValue(flow.AppID),
+		Bytes:       types.Int64Value(int64(flow.Bytes)),
+		DeviceID:    types.StringValue(flow.DeviceID),
+		GroupID:     types.Int64Value(int64(flow.GroupID)),
+		ID:          types.StringValue(flow.ID),
+		IsPermanent: types.BoolValue(flow.IsPermanent),
+		LastSeen:    types.Int64Value(int64(flow.LastSeen)),
+		Life:        types.Int64Value(int64(flow.Life)),
+		LiveType:    types.StringValue(flow.LiveType),
+		Packets:     types.Int64Value(int64(flow.Packets)),
+		Priority:    types.Int64Value(int64(flow.Priority)),
+		State:       types.StringValue(flow.State),
+		TableID:     types.Int64Value(int64(flow.TableID)),
+		TableName:   types.StringValue(flow.TableName),
+		Timeout:     types.Int64Value(int64(flow.Timeout)),
+		Selector: flowsSelectorModel{
+			Criteria: []flowsSelectorCriteriaModel{},
+		},
+		Treatment: flowsTreatmentModel{
+			ClearDeferred: types.BoolValue(flow.Treatment.ClearDeferred),
+			Deferred:      flattenFlowInstructions(flow.Treatment.Deferred),
+			Instructions:  flattenFlowInstructions(flow.Treatment.Instructions),
+		},
+	}
+	for _, criteria := range flow.Selector.Criteria {
+		m.Selector.Criteria = append(m.Selector.Criteria, flowsSelectorCriteriaModel{
+			EthType: types.StringValue(criteria.EthType),
+			Mac:     types.StringValue(criteria.Mac),
+			Port:    types.Int64Value(int64(criteria.Port)),
+			Type:    types.StringValue(criteria.Type),
+		})
+	}
+	return m
+}
+
+func flattenFlowInstructions(instructions []onosclient.Instructions) []flowsTreatmentInstructionsModel {
+	models := []flowsTreatmentInstructionsModel{}
+	for _, instruction := range instructions {
+		models = append(models, flowsTreatmentInstructionsModel{
+			Port: types.StringValue(instruction.Port),
+			Type: types.StringValue(instruction.Type),
+		})
+	}
+	return models
+}
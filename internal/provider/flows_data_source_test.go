@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFlattenFlow(t *testing.T) {
+	tests := []struct {
+		name string
+		flow onosclient.Flow
+		want flowsModel
+	}{
+		{
+			name: "full flow",
+			flow: onosclient.Flow{
+				AppID:       "org.onosproject.core",
+				Bytes:       1024,
+				DeviceID:    "of:0000000000000001",
+				GroupID:     1,
+				ID:          "281475519188140",
+				IsPermanent: true,
+				LastSeen:    1697702400000,
+				Life:        60,
+				LiveType:    "UNKNOWN",
+				Packets:     16,
+				Priority:    40000,
+				State:       "ADDED",
+				TableID:     0,
+				TableName:   "0",
+				Timeout:     0,
+				Selector: onosclient.Selector{
+					Criteria: []onosclient.Criteria{{Type: "ETH_TYPE", EthType: "0x88cc"}},
+				},
+				Treatment: onosclient.Treatment{
+					ClearDeferred: true,
+					Instructions:  []onosclient.Instructions{{Type: "OUTPUT", Port: "CONTROLLER"}},
+					Deferred:      []onosclient.Instructions{{Type: "OUTPUT", Port: "1"}},
+				},
+			},
+			want: flowsModel{
+				AppID:       types.StringValue("org.onosproject.core"),
+				Bytes:       types.Int64Value(1024),
+				DeviceID:    types.StringValue("of:0000000000000001"),
+				GroupID:     types.Int64Value(1),
+				ID:          types.StringValue("281475519188140"),
+				IsPermanent: types.BoolValue(true),
+				LastSeen:    types.Int64Value(1697702400000),
+				Life:        types.Int64Value(60),
+				LiveType:    types.StringValue("UNKNOWN"),
+				Packets:     types.Int64Value(16),
+				Priority:    types.Int64Value(40000),
+				State:       types.StringValue("ADDED"),
+				TableID:     types.Int64Value(0),
+				TableName:   types.StringValue("0"),
+				Timeout:     types.Int64Value(0),
+				Selector: flowsSelectorModel{
+					Criteria: []flowsSelectorCriteriaModel{{
+						EthType: types.StringValue("0x88cc"),
+						Mac:     types.StringValue(""),
+						Port:    types.Int64Value(0),
+						Type:    types.StringValue("ETH_TYPE"),
+					}},
+				},
+				Treatment: flowsTreatmentModel{
+					ClearDeferred: types.BoolValue(true),
+					Deferred: []flowsTreatmentInstructionsModel{{
+						Port: types.StringValue("1"),
+						Type: types.StringValue("OUTPUT"),
+					}},
+					Instructions: []flowsTreatmentInstructionsModel{{
+						Port: types.StringValue("CONTROLLER"),
+						Type: types.StringValue("OUTPUT"),
+					}},
+				},
+			},
+		},
+		{
+			name: "partial flow",
+			flow: onosclient.Flow{
+				ID:       "1",
+				DeviceID: "of:0000000000000001",
+			},
+			want: flowsModel{
+				AppID:       types.StringValue(""),
+				Bytes:       types.Int64Value(0),
+				DeviceID:    types.StringValue("of:0000000000000001"),
+				GroupID:     types.Int64Value(0),
+				ID:          types.StringValue("1"),
+				IsPermanent: types.BoolValue(false),
+				LastSeen:    types.Int64Value(0),
+				Life:        types.Int64Value(0),
+				LiveType:    types.StringValue(""),
+				Packets:     types.Int64Value(0),
+				Priority:    types.Int64Value(0),
+				State:       types.StringValue(""),
+				TableID:     types.Int64Value(0),
+				TableName:   types.StringValue(""),
+				Timeout:     types.Int64Value(0),
+				Selector: flowsSelectorModel{
+					Criteria: []flowsSelectorCriteriaModel{},
+				},
+				Treatment: flowsTreatmentModel{
+					ClearDeferred: types.BoolValue(false),
+					Deferred:      []flowsTreatmentInstructionsModel{},
+					Instructions:  []flowsTreatmentInstructionsModel{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flattenFlow(tt.flow); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFlattenFlowInstructions(t *testing.T) {
+	tests := []struct {
+		name         string
+		instructions []onosclient.Instructions
+		want         int
+	}{
+		{name: "nil", instructions: nil, want: 0},
+		{name: "empty", instructions: []onosclient.Instructions{}, want: 0},
+		{name: "partial instruction", instructions: []onosclient.Instructions{{Type: "NOACTION"}}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flattenFlowInstructions(tt.instructions)
+			// Empty lists are set instead of null ones.
+			if got == nil || len(got) != tt.want {
+				t.Fatalf("expected %d instructions, got %+v", tt.want, got)
+			}
+			for i, instruction := range got {
+				if instruction.Port.IsNull() || instruction.Type.ValueString() != tt.instructions[i].Type {
+					t.Fatalf("unexpected instruction %+v", instruction)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &forwardingObjectiveResource{}
+	_ resource.ResourceWithConfigure        = &forwardingObjectiveResource{}
+	_ resource.ResourceWithConfigValidators = &forwardingObjectiveResource{}
+)
+
+// forwardingObjectiveResource is the resource implementation.
+type forwardingObjectiveResource struct {
+	client *onosclient.Client
+}
+
+// NewForwardingObjectiveResource is a helper function to simplify the provider implementation.
+func NewForwardingObjectiveResource() resource.Resource {
+	return &forwardingObjectiveResource{}
+}
+
+type forwardingObjectiveResourceModel struct {
+	ID          types.String             `tfsdk:"id"`
+	DeviceID    types.String             `tfsdk:"device_id"`
+	AppID       types.String             `tfsdk:"app_id"`
+	Flag        types.String             `tfsdk:"flag"`
+	Priority    types.Int64              `tfsdk:"priority"`
+	Timeout     types.Int64              `tfsdk:"timeout"`
+	Selector    objectiveSelectorModel   `tfsdk:"selector"`
+	Treatment   *objectiveTreatmentModel `tfsdk:"treatment"`
+	NextID      types.Int64              `tfsdk:"next_id"`
+	LastUpdated types.String             `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (r *forwardingObjectiveResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forwarding_objective"
+}
+
+// Schema defines the schema for the resource.
+func (r *forwardingObjectiveResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a forwarding objective on a device. ONOS does not expose installed objectives for reading, " +
+			"so the state reflects the last applied configuration and any change replaces the objective.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the forwarding objective assigned by ONOS.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device the objective is installed on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Description: "ID of the app that owns the objective. Defaults to org.onosproject.rest.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("org.onosproject.rest"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flag": schema.StringAttribute{
+				Description: "Flag of the objective, SPECIFIC or VERSATILE.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("SPECIFIC", "VERSATILE"),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Priority of the objective.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Idle timeout of the objective in seconds. Defaults to 0, which makes the objective permanent.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"selector": schema.SingleNestedAttribute{
+				Description: "Traffic selector of the objective.",
+				Required:    true,
+				Attributes:  objectiveSelectorAttributes(),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"treatment": schema.SingleNestedAttribute{
+				Description: "Traffic treatment of the objective. Either treatment or next_id must be set.",
+				Optional:    true,
+				Attributes:  objectiveTreatmentAttributes(),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_id": schema.Int64Attribute{
+				Description: "ID of the next objective the matched traffic is handed to.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the objective.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators ensures the objective has somewhere to send the traffic.
+func (r *forwardingObjectiveResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("treatment"),
+			path.MatchRoot("next_id"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *forwardingObjectiveResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *forwardingObjectiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan forwardingObjectiveResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, err := onosRequestWithHeaders(ctx, r.client, "POST", forwardingObjectivePath(plan.DeviceID.ValueString()), expandForwardingObjective(plan, "ADD"), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating forwarding objective",
+			"Could not create forwarding objective, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(locationID(headers))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the existing state, as ONOS does not return installed objectives.
+func (r *forwardingObjectiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state forwardingObjectiveResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only refreshes the timestamp; every configurable attribute requires
+// replacement.
+func (r *forwardingObjectiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan forwardingObjectiveResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the objective by sending it again with the REMOVE operation.
+func (r *forwardingObjectiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state forwardingObjectiveResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", forwardingObjectivePath(state.DeviceID.ValueString()), expandForwardingObjective(state, "REMOVE"), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos forwarding objective",
+			"Could not delete forwarding objective, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func forwardingObjectivePath(deviceID string) string {
+	return "/flowobjectives/" + url.PathEscape(deviceID) + "/forward"
+}
+
+// expandForwardingObjective builds the ONOS forwarding objective JSON for the
+// given operation.
+func expandForwardingObjective(m forwardingObjectiveResourceModel, operation string) map[string]any {
+	objective := map[string]any{
+		"appId":       m.AppID.ValueString(),
+		"flag":        m.Flag.ValueString(),
+		"priority":    m.Priority.ValueInt64(),
+		"timeout":     m.Timeout.ValueInt64(),
+		"isPermanent": m.Timeout.ValueInt64() == 0,
+		"operation":   operation,
+		"selector":    expandObjectiveSelector(&m.Selector),
+	}
+	if m.Treatment != nil {
+		objective["treatment"] = expandObjectiveTreatment(m.Treatment)
+	}
+	setIfKnown(objective, "nextId", m.NextID)
+	return objective
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccForwardingObjectiveResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_next_objective" "test" {
+					device_id = "of:0000000000000001"
+					type      = "SIMPLE"
+					treatments = [
+					  {
+						instructions = [
+						  {
+							type = "OUTPUT"
+							port = "2"
+						  },
+						]
+					  },
+					]
+				  }
+
+				resource "onos_forwarding_objective" "test" {
+					device_id = "of:0000000000000001"
+					flag      = "SPECIFIC"
+					priority  = 40000
+					selector = {
+					  criteria = [
+						{
+						  type     = "ETH_TYPE"
+						  eth_type = "0x0800"
+						},
+					  ]
+					}
+					next_id = onos_next_objective.test.next_id
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_forwarding_objective.test", "flag", "SPECIFIC"),
+					resource.TestCheckResourceAttr("onos_forwarding_objective.test", "priority", "40000"),
+					resource.TestCheckResourceAttr("onos_forwarding_objective.test", "timeout", "0"),
+					resource.TestCheckResourceAttr("onos_forwarding_objective.test", "app_id", "org.onosproject.rest"),
+					resource.TestCheckResourceAttr("onos_forwarding_objective.test", "selector.criteria.#", "1"),
+					resource.TestCheckResourceAttrPair("onos_forwarding_objective.test", "next_id", "onos_next_objective.test", "next_id"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("onos_forwarding_objective.test", "id"),
+					resource.TestCheckResourceAttrSet("onos_next_objective.test", "next_id"),
+					resource.TestCheckResourceAttrSet("onos_next_objective.test", "last_updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestExpandForwardingObjective(t *testing.T) {
+	tests := []struct {
+		name  string
+		model forwardingObjectiveResourceModel
+		want  string
+	}{
+		{
+			name: "next objective",
+			model: forwardingObjectiveResourceModel{
+				AppID:    types.StringValue("org.onosproject.rest"),
+				Flag:     types.StringValue("SPECIFIC"),
+				Priority: types.Int64Value(40000),
+				Timeout:  types.Int64Value(0),
+				Selector: objectiveSelectorModel{Criteria: []objectiveCriterionModel{
+					{Type: types.StringValue("ETH_TYPE"), EthType: types.StringValue("0x0800"), Port: types.Int64Null(), Mac: types.StringNull()},
+				}},
+				NextID: types.Int64Value(5),
+			},
+			want: `{
+				"appId": "org.onosproject.rest", "flag": "SPECIFIC", "priority": 40000, "timeout": 0,
+				"isPermanent": true, "operation": "ADD", "nextId": 5,
+				"selector": {"criteria": [{"type": "ETH_TYPE", "ethType": "0x0800"}]}
+			}`,
+		},
+		{
+			name: "treatment with timeout",
+			model: forwardingObjectiveResourceModel{
+				AppID:    types.StringValue("org.onosproject.rest"),
+				Flag:     types.StringValue("VERSATILE"),
+				Priority: types.Int64Value(100),
+				Timeout:  types.Int64Value(30),
+				Selector: objectiveSelectorModel{Criteria: []objectiveCriterionModel{
+					{Type: types.StringValue("IN_PORT"), Port: types.Int64Value(1)},
+				}},
+				Treatment: &objectiveTreatmentModel{Instructions: []objectiveInstructionModel{
+					{Type: types.StringValue("OUTPUT"), Port: types.StringValue("CONTROLLER"), MeterID: types.StringUnknown()},
+					{Type: types.StringValue("L2MODIFICATION"), Subtype: types.StringValue("VLAN_ID"), VlanID: types.Int64Value(10)},
+				}},
+				NextID: types.Int64Null(),
+			},
+			want: `{
+				"appId": "org.onosproject.rest", "flag": "VERSATILE", "priority": 100, "timeout": 30,
+				"isPermanent": false, "operation": "ADD",
+				"selector": {"criteria": [{"type": "IN_PORT", "port": 1}]},
+				"treatment": {"instructions": [
+					{"type": "OUTPUT", "port": "CONTROLLER"},
+					{"type": "L2MODIFICATION", "subtype": "VLAN_ID", "vlanId": 10}
+				]}
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertObjectiveJSON(t, expandForwardingObjective(tt.model, "ADD"), tt.want)
+		})
+	}
+}
+
+func TestExpandNextObjective(t *testing.T) {
+	tests := []struct {
+		name  string
+		model nextObjectiveResourceModel
+		want  string
+	}{
+		{
+			name: "simple",
+			model: nextObjectiveResourceModel{
+				NextID: types.Int64Value(5),
+				AppID:  types.StringValue("org.onosproject.rest"),
+				Type:   types.StringValue("SIMPLE"),
+				Treatments: []objectiveTreatmentModel{
+					{Instructions: []objectiveInstructionModel{{Type: types.StringValue("OUTPUT"), Port: types.StringValue("2")}}},
+				},
+			},
+			want: `{
+				"id": 5, "appId": "org.onosproject.rest", "type": "SIMPLE", "operation": "REMOVE", "isPermanent": true,
+				"treatments": [{"instructions": [{"type": "OUTPUT", "port": "2"}]}]
+			}`,
+		},
+		{
+			name: "meta",
+			model: nextObjectiveResourceModel{
+				NextID:     types.Int64Value(6),
+				AppID:      types.StringValue("org.onosproject.rest"),
+				Type:       types.StringValue("HASHED"),
+				Treatments: []objectiveTreatmentModel{},
+				Meta: &objectiveSelectorModel{Criteria: []objectiveCriterionModel{
+					{Type: types.StringValue("VLAN_VID"), VlanID: types.Int64Value(10)},
+				}},
+			},
+			want: `{
+				"id": 6, "appId": "org.onosproject.rest", "type": "HASHED", "operation": "REMOVE", "isPermanent": true,
+				"treatments": [],
+				"meta": {"criteria": [{"type": "VLAN_VID", "vlanId": 10}]}
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertObjectiveJSON(t, expandNextObjective(tt.model, "REMOVE"), tt.want)
+		})
+	}
+}
+
+// assertObjectiveJSON compares the JSON encoding of the objective with the
+// expected JSON document, ignoring the order of the object keys.
+func assertObjectiveJSON(t *testing.T, objective map[string]any, want string) {
+	t.Helper()
+
+	body, err := json.Marshal(objective)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var got, expected any
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := json.Unmarshal([]byte(want), &expected); err != nil {
+		t.Fatalf("invalid expected JSON: %s", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %s, got %s", want, body)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	onosclient "github.com/ctjnkns/onos-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -129,24 +130,26 @@ func (d *hostsDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*onosclient.Client)
+	data, ok := req.ProviderData.(*onosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *onos.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state hostsDataSourceModel
+	state := hostsDataSourceModel{
+		Hosts: []hostsModel{},
+	}
 	hosts, err := d.client.GetHosts()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -157,32 +160,11 @@ func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	for _, host := range hosts.Hosts {
-
-		//https://github.com/buildkite/terraform-provider-buildkite/blob/2b6b85c94482a0b5ff44beb4b24296016ac31659/buildkite/data_source_meta.go#L57
-		ips, diag := types.ListValueFrom(ctx, types.StringType, host.IPAddresses)
-		if diag.HasError() {
-			resp.Diagnostics.Append(diag...)
+		hostState, diags := flattenHost(ctx, host)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		hostState := hostsModel{
-			ID:          types.StringValue(host.ID),
-			Mac:         types.StringValue(host.Mac),
-			Vlan:        types.StringValue(host.Vlan),
-			InnerVlan:   types.StringValue(host.InnerVlan),
-			OuterTpid:   types.StringValue(host.OuterTpid),
-			Configured:  types.BoolValue(host.Configured),
-			Suspended:   types.BoolValue(host.Suspended),
-			IPAddresses: ips,
-			Locations:   []hostsLocationsModel{},
-		}
-
-		for _, location := range host.Locations {
-			hostState.Locations = append(hostState.Locations, hostsLocationsModel{
-				ElementID: types.StringValue(location.ElementID),
-				Port:      types.StringValue(location.Port),
-			})
-		}
 		state.Hosts = append(state.Hosts, hostState)
 	}
 
@@ -194,3 +176,35 @@ func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 }
+
+// flattenHost returns the model of a host read from ONOS. A host without IP
+// addresses or locations has empty lists, not null ones.
+func flattenHost(ctx context.Context, host onosclient.Host) (hostsModel, diag.Diagnostics) {
+	ipAddresses := host.IPAddresses
+	if ipAddresses == nil {
+		ipAddresses = []string{}
+	}
+	ips, diags := types.ListValueFrom(ctx, types.StringType, ipAddresses)
+	if diags.HasError() {
+		return hostsModel{}, diags
+	}
+
+	m := hostsModel{
+		ID:          types.StringValue(host.ID),
+		Mac:         types.StringValue(host.Mac),
+		Vlan:        types.StringValue(host.Vlan),
+		InnerVlan:   types.StringValue(host.InnerVlan),
+		OuterTpid:   types.StringValue(host.OuterTpid),
+		Configured:  types.BoolValue(host.Configured),
+		Suspended:   types.BoolValue(host.Suspended),
+		IPAddresses: ips,
+		Locations:   []hostsLocationsModel{},
+	}
+	for _, location := range host.Locations {
+		m.Locations = append(m.Locations, hostsLocationsModel{
+			ElementID: types.StringValue(location.ElementID),
+			Port:      types.StringValue(location.Port),
+		})
+	}
+	return m, diags
+}
@@ -1,13 +1,18 @@
 package provider
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccHostsDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Read testing
@@ -36,3 +41,75 @@ func TestAccHostsDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestFlattenHost(t *testing.T) {
+	tests := []struct {
+		name          string
+		host          onosclient.Host
+		wantIPs       []string
+		wantLocations []hostsLocationsModel
+	}{
+		{
+			name: "full host",
+			host: onosclient.Host{
+				ID:          "00:00:00:00:00:01/None",
+				Mac:         "00:00:00:00:00:01",
+				Vlan:        "None",
+				InnerVlan:   "None",
+				OuterTpid:   "0x0000",
+				IPAddresses: []string{"10.0.0.1", "2000::1"},
+				Locations: []onosclient.Location{
+					{ElementID: "of:0000000000000002", Port: "1"},
+				},
+			},
+			wantIPs: []string{"10.0.0.1", "2000::1"},
+			wantLocations: []hostsLocationsModel{
+				{ElementID: types.StringValue("of:0000000000000002"), Port: types.StringValue("1")},
+			},
+		},
+		{
+			name: "host without addresses and locations",
+			host: onosclient.Host{
+				ID:  "00:00:00:00:00:05/None",
+				Mac: "00:00:00:00:00:05",
+			},
+			wantIPs:       []string{},
+			wantLocations: []hostsLocationsModel{},
+		},
+		{
+			name: "empty addresses and locations",
+			host: onosclient.Host{
+				ID:          "00:00:00:00:00:05/None",
+				Mac:         "00:00:00:00:00:05",
+				IPAddresses: []string{},
+				Locations:   []onosclient.Location{},
+			},
+			wantIPs:       []string{},
+			wantLocations: []hostsLocationsModel{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			got, diags := flattenHost(ctx, tt.host)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			wantIPs, diags := types.ListValueFrom(ctx, types.StringType, tt.wantIPs)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !got.IPAddresses.Equal(wantIPs) {
+				t.Errorf("expected IP addresses %s, got %s", wantIPs, got.IPAddresses)
+			}
+			if !reflect.DeepEqual(got.Locations, tt.wantLocations) {
+				t.Errorf("expected locations %+v, got %+v", tt.wantLocations, got.Locations)
+			}
+			if got.ID.ValueString() != tt.host.ID || got.Vlan.IsNull() || got.Configured.IsNull() {
+				t.Errorf("unexpected host %+v", got)
+			}
+		})
+	}
+}
@@ -152,18 +152,18 @@ func (r *intentResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*onosclient.Client)
+	data, ok := req.ProviderData.(*onosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *onos.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -178,14 +178,7 @@ func (r *intentResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Generate API request body from plan
-	intent := onosclient.Intent{
-		AppID:    plan.Intent.AppID.ValueString(),
-		Key:      plan.Intent.Key.ValueString(),
-		Type:     plan.Intent.Type.ValueString(),
-		Priority: int(plan.Intent.Priority.ValueInt64()),
-		One:      plan.Intent.One.ValueString(),
-		Two:      plan.Intent.Two.ValueString(),
-	}
+	intent := expandIntent(plan.Intent)
 
 	// Create new intent
 	intent, err := r.client.CreateIntent(intent)
@@ -199,15 +192,7 @@ func (r *intentResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(intent.ID)
 
-	plan.Intent = intentModel{
-		ID:       types.StringValue(intent.ID),
-		AppID:    types.StringValue(intent.AppID),
-		Key:      types.StringValue(intent.Key),
-		Type:     types.StringValue(intent.Type),
-		Priority: types.Int64Value(int64(intent.Priority)),
-		One:      types.StringValue(intent.One),
-		Two:      types.StringValue(intent.Two),
-	}
+	plan.Intent = flattenIntent(intent)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Set state to fully populated data
@@ -242,15 +227,7 @@ func (r *intentResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 		// Overwrite intent with refreshed state
 		state.ID = types.StringValue(intent.ID)
-		state.Intent = intentModel{
-			ID:       types.StringValue(intent.ID),
-			AppID:    types.StringValue(intent.AppID),
-			Key:      types.StringValue(intent.Key),
-			Type:     types.StringValue(intent.Type),
-			Priority: types.Int64Value(int64(intent.Priority)),
-			One:      types.StringValue(intent.One),
-			Two:      types.StringValue(intent.Two),
-		}
+		state.Intent = flattenIntent(intent)
 	}
 
 	// Set refreshed state
@@ -271,14 +248,7 @@ func (r *intentResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Generate API request body from plan
-	intent := onosclient.Intent{
-		AppID:    plan.Intent.AppID.ValueString(),
-		Key:      plan.Intent.Key.ValueString(),
-		Type:     plan.Intent.Type.ValueString(),
-		Priority: int(plan.Intent.Priority.ValueInt64()),
-		One:      plan.Intent.One.ValueString(),
-		Two:      plan.Intent.Two.ValueString(),
-	}
+	intent := expandIntent(plan.Intent)
 
 	// Update existing intent
 	intent, err := r.client.UpdateIntent(intent)
@@ -297,15 +267,7 @@ func (r *intentResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update resource state with updated items and timestamp
 	plan.ID = types.StringValue(intent.ID)
 
-	plan.Intent = intentModel{
-		ID:       types.StringValue(intent.ID),
-		AppID:    types.StringValue(intent.AppID),
-		Key:      types.StringValue(intent.Key),
-		Type:     types.StringValue(intent.Type),
-		Priority: types.Int64Value(int64(intent.Priority)),
-		One:      types.StringValue(intent.One),
-		Two:      types.StringValue(intent.Two),
-	}
+	plan.Intent = flattenIntent(intent)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Set state to fully populated data
@@ -368,3 +330,31 @@ func (r *intentResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 }
+
+// expandIntent returns the API request body of an intent. Null and unknown
+// values are sent as zero values, which onosclient omits or rejects.
+func expandIntent(m intentModel) onosclient.Intent {
+	return onosclient.Intent{
+		AppID:    m.AppID.ValueString(),
+		Key:      m.Key.ValueString(),
+		Type:     m.Type.ValueString(),
+		Priority: int(m.Priority.ValueInt64()),
+		One:      m.One.ValueString(),
+		Two:      m.Two.ValueString(),
+	}
+}
+
+// flattenIntent returns the model of an intent read from ONOS. Fields missing
+// from the API response are set to their zero value, never to null, as the
+// attributes are required.
+func flattenIntent(intent onosclient.Intent) intentModel {
+	return intentModel{
+		ID:       types.StringValue(intent.ID),
+		AppID:    types.StringValue(intent.AppID),
+		Key:      types.StringValue(intent.Key),
+		Type:     types.StringValue(intent.Type),
+		Priority: types.Int64Value(int64(intent.Priority)),
+		One:      types.StringValue(intent.One),
+		Two:      types.StringValue(intent.Two),
+	}
+}
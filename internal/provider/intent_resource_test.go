@@ -1,13 +1,17 @@
 package provider
 
 import (
+	"reflect"
 	"testing"
 
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccIntentResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -79,3 +83,150 @@ func TestAccIntentResource(t *testing.T) {
 		},
 	})
 }
+
+func TestExpandIntent(t *testing.T) {
+	tests := []struct {
+		name  string
+		model intentModel
+		want  onosclient.Intent
+	}{
+		{
+			name: "all values",
+			model: intentModel{
+				ID:       types.StringValue("0x1"),
+				AppID:    types.StringValue("org.onosproject.cli"),
+				Key:      types.StringValue("0x99999"),
+				Type:     types.StringValue("HostToHostIntent"),
+				Priority: types.Int64Value(100),
+				One:      types.StringValue("00:00:00:00:00:01/None"),
+				Two:      types.StringValue("00:00:00:00:00:02/None"),
+			},
+			// The ID is computed by ONOS, it is not sent.
+			want: onosclient.Intent{
+				AppID:    "org.onosproject.cli",
+				Key:      "0x99999",
+				Type:     "HostToHostIntent",
+				Priority: 100,
+				One:      "00:00:00:00:00:01/None",
+				Two:      "00:00:00:00:00:02/None",
+			},
+		},
+		{
+			name: "null values",
+			model: intentModel{
+				ID:       types.StringNull(),
+				AppID:    types.StringNull(),
+				Key:      types.StringNull(),
+				Type:     types.StringNull(),
+				Priority: types.Int64Null(),
+				One:      types.StringNull(),
+				Two:      types.StringNull(),
+			},
+			want: onosclient.Intent{},
+		},
+		{
+			name: "unknown values",
+			model: intentModel{
+				ID:       types.StringUnknown(),
+				AppID:    types.StringValue("org.onosproject.cli"),
+				Key:      types.StringUnknown(),
+				Type:     types.StringValue("HostToHostIntent"),
+				Priority: types.Int64Unknown(),
+				One:      types.StringUnknown(),
+				Two:      types.StringValue("00:00:00:00:00:02/None"),
+			},
+			want: onosclient.Intent{
+				AppID: "org.onosproject.cli",
+				Type:  "HostToHostIntent",
+				Two:   "00:00:00:00:00:02/None",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expandIntent(tt.model); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFlattenIntent(t *testing.T) {
+	tests := []struct {
+		name   string
+		intent onosclient.Intent
+		want   intentModel
+	}{
+		{
+			name: "full intent",
+			intent: onosclient.Intent{
+				AppID:     "org.onosproject.cli",
+				ID:        "0x1",
+				Key:       "0x99999",
+				State:     "INSTALLED",
+				Type:      "HostToHostIntent",
+				Resources: []string{"00:00:00:00:00:01/None", "00:00:00:00:00:02/None"},
+				Priority:  100,
+				One:       "00:00:00:00:00:01/None",
+				Two:       "00:00:00:00:00:02/None",
+			},
+			want: intentModel{
+				ID:       types.StringValue("0x1"),
+				AppID:    types.StringValue("org.onosproject.cli"),
+				Key:      types.StringValue("0x99999"),
+				Type:     types.StringValue("HostToHostIntent"),
+				Priority: types.Int64Value(100),
+				One:      types.StringValue("00:00:00:00:00:01/None"),
+				Two:      types.StringValue("00:00:00:00:00:02/None"),
+			},
+		},
+		{
+			name: "partial intent",
+			intent: onosclient.Intent{
+				AppID: "org.onosproject.cli",
+				Key:   "0x99999",
+				Type:  "PointToPointIntent",
+			},
+			want: intentModel{
+				ID:       types.StringValue(""),
+				AppID:    types.StringValue("org.onosproject.cli"),
+				Key:      types.StringValue("0x99999"),
+				Type:     types.StringValue("PointToPointIntent"),
+				Priority: types.Int64Value(0),
+				One:      types.StringValue(""),
+				Two:      types.StringValue(""),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flattenIntent(tt.intent); got != tt.want {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIntentRoundTrip(t *testing.T) {
+	model := intentModel{
+		ID:       types.StringValue("0x1"),
+		AppID:    types.StringValue("org.onosproject.cli"),
+		Key:      types.StringValue("0x99999"),
+		Type:     types.StringValue("HostToHostIntent"),
+		Priority: types.Int64Value(100),
+		One:      types.StringValue("00:00:00:00:00:01/None"),
+		Two:      types.StringValue("00:00:00:00:00:02/None"),
+	}
+
+	intent := expandIntent(model)
+	// ONOS answers with the ID of the intent.
+	intent.ID = model.ID.ValueString()
+	if got := flattenIntent(intent); got != model {
+		t.Fatalf("expected %+v, got %+v", model, got)
+	}
+	if got := expandIntent(flattenIntent(intent)); !reflect.DeepEqual(got, expandIntent(model)) {
+		t.Fatalf("expected %+v, got %+v", expandIntent(model), got)
+	}
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &linkStatisticsDataSource{}
+	_ datasource.DataSourceWithConfigure = &linkStatisticsDataSource{}
+)
+
+// NewLinkStatisticsDataSource is a helper function to simplify the provider implementation.
+func NewLinkStatisticsDataSource() datasource.DataSource {
+	return &linkStatisticsDataSource{}
+}
+
+// linkStatisticsDataSource is the data source implementation.
+type linkStatisticsDataSource struct {
+	client *onosclient.Client
+}
+
+type linkStatisticsDataSourceModel struct {
+	DeviceID types.String          `tfsdk:"device_id"`
+	Port     types.String          `tfsdk:"port"`
+	Links    []linkStatisticsModel `tfsdk:"links"`
+}
+
+type linkStatisticsModel struct {
+	Src    hostsLocationsModel `tfsdk:"src"`
+	Dst    hostsLocationsModel `tfsdk:"dst"`
+	Rate   types.Int64         `tfsdk:"rate"`
+	Latest types.Int64         `tfsdk:"latest"`
+	Valid  types.Bool          `tfsdk:"valid"`
+	Time   types.Int64         `tfsdk:"time"`
+}
+
+// onosLinkLoads maps the ONOS link load response.
+type onosLinkLoads struct {
+	Loads []onosLinkLoad `json:"loads"`
+}
+
+// onosLinkLoad maps the load of one link.
+type onosLinkLoad struct {
+	Link   onosLink `json:"link"`
+	Rate   int64    `json:"rate"`
+	Latest int64    `json:"latest"`
+	Valid  bool     `json:"valid"`
+	Time   int64    `json:"time"`
+}
+
+// onosLink maps an ONOS infrastructure link.
+type onosLink struct {
+	Src   onosConnectPoint `json:"src"`
+	Dst   onosConnectPoint `json:"dst"`
+	Type  string           `json:"type"`
+	State string           `json:"state"`
+}
+
+// onosConnectPoint maps an ONOS connect point.
+type onosConnectPoint struct {
+	Device string `json:"device"`
+	Port   string `json:"port"`
+}
+
+// Metadata returns the data source type name.
+func (d *linkStatisticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link_statistics"
+}
+
+// Schema defines the schema for the data source.
+func (d *linkStatisticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	connectPointAttributes := map[string]schema.Attribute{
+		"elementid": schema.StringAttribute{
+			Description: "ID of the device.",
+			Computed:    true,
+		},
+		"port": schema.StringAttribute{
+			Description: "Port number on the device.",
+			Computed:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the load of the infrastructure links.",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Description: "Only return the links egressing this device.",
+				Optional:    true,
+			},
+			"port": schema.StringAttribute{
+				Description: "Only return the link egressing this port number. Requires device_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("device_id")),
+				},
+			},
+			"links": schema.ListNestedAttribute{
+				Description: "Load per link.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"src": schema.SingleNestedAttribute{
+							Description: "Source connect point of the link.",
+							Computed:    true,
+							Attributes:  connectPointAttributes,
+						},
+						"dst": schema.SingleNestedAttribute{
+							Description: "Destination connect point of the link.",
+							Computed:    true,
+							Attributes:  connectPointAttributes,
+						},
+						"rate": schema.Int64Attribute{
+							Description: "Load of the link in bytes per second.",
+							Computed:    true,
+						},
+						"latest": schema.Int64Attribute{
+							Description: "Latest byte counter of the link.",
+							Computed:    true,
+						},
+						"valid": schema.BoolAttribute{
+							Description: "Whether the load is based on recent statistics.",
+							Computed:    true,
+						},
+						"time": schema.Int64Attribute{
+							Description: "Time the load was computed in milliseconds since the epoch.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *linkStatisticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *linkStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state linkStatisticsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/statistics/flows/link"
+	query := url.Values{}
+	if !state.DeviceID.IsNull() {
+		query.Set("device", state.DeviceID.ValueString())
+	}
+	if !state.Port.IsNull() {
+		query.Set("port", state.Port.ValueString())
+	}
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var loads onosLinkLoads
+	err := onosRequest(ctx, d.client, "GET", endpoint, nil, &loads)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Link Statistics",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Links = []linkStatisticsModel{}
+	for _, load := range loads.Loads {
+		state.Links = append(state.Links, linkStatisticsModel{
+			Src:    flattenConnectPoint(load.Link.Src),
+			Dst:    flattenConnectPoint(load.Link.Dst),
+			Rate:   types.Int64Value(load.Rate),
+			Latest: types.Int64Value(load.Latest),
+			Valid:  types.BoolValue(load.Valid),
+			Time:   types.Int64Value(load.Time),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flattenConnectPoint converts a connect point to the location format used by
+// the hosts data source.
+func flattenConnectPoint(connectPoint onosConnectPoint) hostsLocationsModel {
+	return hostsLocationsModel{
+		ElementID: types.StringValue(connectPoint.Device),
+		Port:      types.StringValue(connectPoint.Port),
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLinkStatisticsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "onos_link_statistics" "test" {
+					device_id = "of:0000000000000001"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onos_link_statistics.test", "links.0.src.elementid", "of:0000000000000001"),
+					resource.TestCheckResourceAttrSet("data.onos_link_statistics.test", "links.0.src.port"),
+					resource.TestCheckResourceAttrSet("data.onos_link_statistics.test", "links.0.dst.elementid"),
+					resource.TestCheckResourceAttrSet("data.onos_link_statistics.test", "links.0.dst.port"),
+					resource.TestCheckResourceAttrSet("data.onos_link_statistics.test", "links.0.rate"),
+					resource.TestCheckResourceAttrSet("data.onos_link_statistics.test", "links.0.valid"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenConnectPoint(t *testing.T) {
+	body := `{"loads": [{
+		"link": {
+			"src": {"device": "of:0000000000000001", "port": "2"},
+			"dst": {"device": "of:0000000000000002", "port": "3"},
+			"type": "DIRECT",
+			"state": "ACTIVE"
+		},
+		"rate": 1500, "latest": 30000, "valid": true, "time": 1700000000000
+	}]}`
+	var loads onosLinkLoads
+	if err := json.Unmarshal([]byte(body), &loads); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		name         string
+		connectPoint onosConnectPoint
+		want         hostsLocationsModel
+	}{
+		{
+			name:         "src",
+			connectPoint: loads.Loads[0].Link.Src,
+			want:         hostsLocationsModel{ElementID: types.StringValue("of:0000000000000001"), Port: types.StringValue("2")},
+		},
+		{
+			name:         "dst",
+			connectPoint: loads.Loads[0].Link.Dst,
+			want:         hostsLocationsModel{ElementID: types.StringValue("of:0000000000000002"), Port: types.StringValue("3")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flattenConnectPoint(tt.connectPoint); got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &mastershipDataSource{}
+	_ datasource.DataSourceWithConfigure = &mastershipDataSource{}
+)
+
+// NewMastershipDataSource is a helper function to simplify the provider implementation.
+func NewMastershipDataSource() datasource.DataSource {
+	return &mastershipDataSource{}
+}
+
+// mastershipDataSource is the data source implementation.
+type mastershipDataSource struct {
+	client *onosclient.Client
+}
+
+type mastershipDataSourceModel struct {
+	Devices []mastershipModel `tfsdk:"devices"`
+}
+
+type mastershipModel struct {
+	DeviceID     types.String `tfsdk:"device_id"`
+	MasterNodeID types.String `tfsdk:"master_node_id"`
+	Standbys     types.List   `tfsdk:"standbys"`
+}
+
+// Metadata returns the data source type name.
+func (d *mastershipDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mastership"
+}
+
+// Schema defines the schema for the data source.
+func (d *mastershipDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the current mastership roles of all devices.",
+		Attributes: map[string]schema.Attribute{
+			"devices": schema.ListNestedAttribute{
+				Description: "Mastership roles per device.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.StringAttribute{
+							Description: "ID of the device.",
+							Computed:    true,
+						},
+						"master_node_id": schema.StringAttribute{
+							Description: "ID of the cluster node that is master of the device.",
+							Computed:    true,
+						},
+						"standbys": schema.ListAttribute{
+							Description: "IDs of the standby nodes in order of preference.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *mastershipDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *mastershipDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state mastershipDataSourceModel
+
+	var devices onosDevices
+	err := onosRequest(ctx, d.client, "GET", "/devices", nil, &devices)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Devices",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Devices = []mastershipModel{}
+	for _, device := range devices.Devices {
+		role, err := getMastershipRole(ctx, d.client, device.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Onos Mastership",
+				"Could not read mastership of device "+device.ID+": "+err.Error(),
+			)
+			return
+		}
+
+		standbys, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(role.Backups))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Devices = append(state.Devices, mastershipModel{
+			DeviceID:     types.StringValue(device.ID),
+			MasterNodeID: types.StringValue(role.Master),
+			Standbys:     standbys,
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
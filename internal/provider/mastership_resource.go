@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &mastershipResource{}
+	_ resource.ResourceWithConfigure   = &mastershipResource{}
+	_ resource.ResourceWithImportState = &mastershipResource{}
+)
+
+// mastershipResource is the resource implementation.
+type mastershipResource struct {
+	client *onosclient.Client
+}
+
+// NewMastershipResource is a helper function to simplify the provider implementation.
+func NewMastershipResource() resource.Resource {
+	return &mastershipResource{}
+}
+
+type mastershipResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	DeviceID     types.String `tfsdk:"device_id"`
+	MasterNodeID types.String `tfsdk:"master_node_id"`
+	Standbys     types.List   `tfsdk:"standbys"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+// onosMastershipRole maps the ONOS role information of a device.
+type onosMastershipRole struct {
+	Master  string   `json:"master"`
+	Backups []string `json:"backups"`
+}
+
+// onosMastershipRequest maps an ONOS request to set the role of a node.
+type onosMastershipRequest struct {
+	DeviceID string `json:"deviceId"`
+	NodeID   string `json:"nodeId"`
+	Role     string `json:"role"`
+}
+
+// Metadata returns the resource type name.
+func (r *mastershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mastership"
+}
+
+// Schema defines the schema for the resource.
+func (r *mastershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pins the mastership of a device to cluster nodes. Destroying the resource leaves the current roles in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the device.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"master_node_id": schema.StringAttribute{
+				Description: "ID of the cluster node that should be master of the device.",
+				Required:    true,
+			},
+			"standbys": schema.ListAttribute{
+				Description: "IDs of the standby nodes in order of preference. Read from ONOS when not set. Only drifts when one of them is no longer a backup node; other backup nodes and the order reported by ONOS are ignored.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the mastership.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mastershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *mastershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mastershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mastershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mastershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := getMastershipRole(ctx, r.client, state.DeviceID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Mastership",
+			"Could not read mastership of device "+state.DeviceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Report the roles as ONOS sees them so failovers show up as drift. ONOS
+	// lists every backup node, so only the standbys in the state are checked,
+	// unless there are none yet after an import.
+	backups := nonNilStrings(role.Backups)
+	if !state.Standbys.IsNull() {
+		var standbys []string
+		resp.Diagnostics.Append(state.Standbys.ElementsAs(ctx, &standbys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		backups = filterStandbys(standbys, backups)
+	}
+	state.ID = state.DeviceID
+	state.MasterNodeID = types.StringValue(role.Master)
+	state.Standbys, diags = types.ListValueFrom(ctx, types.StringType, backups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *mastershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan mastershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the resource from the state; ONOS keeps the current
+// roles until it rebalances.
+func (r *mastershipResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *mastershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID)...)
+}
+
+// apply assigns the master role and then the standby roles in order, and
+// fills the model with the resulting roles.
+func (r *mastershipResource) apply(ctx context.Context, plan *mastershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	deviceID := plan.DeviceID.ValueString()
+
+	requests := []onosMastershipRequest{{DeviceID: deviceID, NodeID: plan.MasterNodeID.ValueString(), Role: "MASTER"}}
+	if !plan.Standbys.IsNull() && !plan.Standbys.IsUnknown() {
+		var standbys []string
+		diags.Append(plan.Standbys.ElementsAs(ctx, &standbys, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		for _, standby := range standbys {
+			requests = append(requests, onosMastershipRequest{DeviceID: deviceID, NodeID: standby, Role: "STANDBY"})
+		}
+	}
+
+	for _, request := range requests {
+		err := onosRequest(ctx, r.client, "PUT", "/mastership", request, nil)
+		if err != nil {
+			diags.AddError(
+				"Error setting mastership",
+				fmt.Sprintf("Could not set node %s as %s of device %s, unexpected error: %s", request.NodeID, request.Role, deviceID, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	role, err := waitForMaster(ctx, r.client, deviceID, plan.MasterNodeID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading mastership",
+			"Could not read mastership of device "+deviceID+" after setting it, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	if role.Master != plan.MasterNodeID.ValueString() {
+		diags.AddError(
+			"Error setting mastership",
+			fmt.Sprintf("Node %s did not become master of device %s; ONOS reports %s as master.", plan.MasterNodeID.ValueString(), deviceID, role.Master),
+		)
+		return diags
+	}
+
+	if plan.Standbys.IsNull() || plan.Standbys.IsUnknown() {
+		var d diag.Diagnostics
+		plan.Standbys, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(role.Backups))
+		diags.Append(d...)
+	}
+	plan.ID = plan.DeviceID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return diags
+}
+
+// mastershipTimeout bounds the wait for a node to become master, and
+// mastershipPollInterval is the delay between its reads.
+var (
+	mastershipTimeout      = 5 * time.Second
+	mastershipPollInterval = 250 * time.Millisecond
+)
+
+// waitForMaster reads the roles of the device until nodeID is reported as
+// master, as role changes are applied asynchronously, or mastershipTimeout
+// passes. It returns the last roles read.
+func waitForMaster(ctx context.Context, client *onosclient.Client, deviceID, nodeID string) (onosMastershipRole, error) {
+	ticker := time.NewTicker(mastershipPollInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(mastershipTimeout)
+	defer timeout.Stop()
+	for {
+		role, err := getMastershipRole(ctx, client, deviceID)
+		if err == nil && role.Master == nodeID {
+			return role, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return role, ctx.Err()
+		case <-timeout.C:
+			return role, err
+		case <-ticker.C:
+		}
+	}
+}
+
+func getMastershipRole(ctx context.Context, client *onosclient.Client, deviceID string) (onosMastershipRole, error) {
+	var role onosMastershipRole
+	err := onosRequest(ctx, client, "GET", "/mastership/"+url.PathEscape(deviceID)+"/role", nil, &role)
+	return role, err
+}
+
+// nonNilStrings returns an empty slice instead of nil so lists are known and
+// empty rather than null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// filterStandbys returns the standbys when ONOS reports all of them as
+// backups, whatever its other backups and their order. Otherwise it returns
+// the backups that are among the standbys, so the missing ones show as drift.
+func filterStandbys(standbys, backups []string) []string {
+	filtered := []string{}
+	for _, backup := range backups {
+		if slices.Contains(standbys, backup) {
+			filtered = append(filtered, backup)
+		}
+	}
+	if len(filtered) == len(standbys) {
+		return standbys
+	}
+	return filtered
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMastershipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				data "onos_mastership" "current" {}
+
+				resource "onos_mastership" "test" {
+					device_id      = "of:0000000000000001"
+					master_node_id = data.onos_mastership.current.devices[0].master_node_id
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_mastership.test", "id", "of:0000000000000001"),
+					resource.TestCheckResourceAttrPair("onos_mastership.test", "master_node_id", "data.onos_mastership.current", "devices.0.master_node_id"),
+					resource.TestCheckResourceAttrSet("onos_mastership.test", "standbys.#"),
+					resource.TestCheckResourceAttrSet("onos_mastership.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_mastership.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestFilterStandbys(t *testing.T) {
+	tests := []struct {
+		name     string
+		standbys []string
+		backups  []string
+		expected []string
+	}{
+		{name: "same", standbys: []string{"node2", "node3"}, backups: []string{"node2", "node3"}, expected: []string{"node2", "node3"}},
+		{name: "other backups", standbys: []string{"node2"}, backups: []string{"node3", "node2", "node4"}, expected: []string{"node2"}},
+		{name: "other order", standbys: []string{"node3", "node2"}, backups: []string{"node2", "node3"}, expected: []string{"node3", "node2"}},
+		{name: "missing standby", standbys: []string{"node2", "node3"}, backups: []string{"node3", "node4"}, expected: []string{"node3"}},
+		{name: "no backups", standbys: []string{"node2"}, expected: []string{}},
+		{name: "no standbys", standbys: []string{}, backups: []string{"node2"}, expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterStandbys(tt.standbys, tt.backups)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWaitForMaster(t *testing.T) {
+	timeout, pollInterval := mastershipTimeout, mastershipPollInterval
+	mastershipTimeout, mastershipPollInterval = 100*time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() { mastershipTimeout, mastershipPollInterval = timeout, pollInterval })
+
+	tests := []struct {
+		name        string
+		roles       []string
+		status      int
+		wantMaster  string
+		wantBackups []string
+		wantError   bool
+	}{
+		{
+			name:        "master at once",
+			roles:       []string{`{"master": "node1", "backups": ["node2", "node3"]}`},
+			wantMaster:  "node1",
+			wantBackups: []string{"node2", "node3"},
+		},
+		{
+			name: "master after a role change",
+			roles: []string{
+				`{"master": "node2", "backups": ["node1"]}`,
+				`{"master": "node1", "backups": ["node2"]}`,
+			},
+			wantMaster:  "node1",
+			wantBackups: []string{"node2"},
+		},
+		{
+			// The last roles read are returned so the drift shows in the state.
+			name:        "never master",
+			roles:       []string{`{"master": "node2"}`},
+			wantMaster:  "node2",
+			wantBackups: nil,
+		},
+		{
+			name:      "device not found",
+			status:    http.StatusNotFound,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reads atomic.Int64
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/onos/v1/mastership/of:0000000000000001/role" {
+					t.Errorf("unexpected request path %s", r.URL.Path)
+				}
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				read := int(reads.Add(1)) - 1
+				_, _ = w.Write([]byte(tt.roles[min(read, len(tt.roles)-1)]))
+			}))
+			defer server.Close()
+			client, err := onosclient.NewClient(server.URL+"/onos/v1", "onos", "rocks")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			role, err := waitForMaster(context.Background(), client, "of:0000000000000001", "node1")
+			if (err != nil) != tt.wantError {
+				t.Fatalf("expected error %t, got %v", tt.wantError, err)
+			}
+			if role.Master != tt.wantMaster || !slices.Equal(role.Backups, tt.wantBackups) {
+				t.Errorf("expected master %q and backups %v, got %+v", tt.wantMaster, tt.wantBackups, role)
+			}
+		})
+	}
+}
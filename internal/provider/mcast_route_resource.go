@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &mcastRouteResource{}
+	_ resource.ResourceWithConfigure   = &mcastRouteResource{}
+	_ resource.ResourceWithImportState = &mcastRouteResource{}
+)
+
+// mcastRouteResource is the resource implementation.
+type mcastRouteResource struct {
+	client *onosclient.Client
+}
+
+// NewMcastRouteResource is a helper function to simplify the provider implementation.
+func NewMcastRouteResource() resource.Resource {
+	return &mcastRouteResource{}
+}
+
+type mcastRouteResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Source      types.String `tfsdk:"source"`
+	Group       types.String `tfsdk:"group"`
+	Sinks       types.Set    `tfsdk:"sinks"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// onosMcastRoutes maps the ONOS multicast routes response.
+type onosMcastRoutes struct {
+	Routes []onosMcastRoute `json:"routes"`
+}
+
+// onosMcastRoute maps an ONOS multicast route.
+type onosMcastRoute struct {
+	Source string   `json:"source"`
+	Group  string   `json:"group"`
+	Sinks  []string `json:"sinks,omitempty"`
+}
+
+// onosMcastSinks maps the sinks of an ONOS multicast route.
+type onosMcastSinks struct {
+	Sinks []string `json:"sinks"`
+}
+
+// Metadata returns the resource type name.
+func (r *mcastRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcast_route"
+}
+
+// Schema defines the schema for the resource.
+func (r *mcastRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a multicast route and its sinks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the route in the form source/group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Source IP address of the route, or * for any source.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.Any(ipAddressValidator{}, stringvalidator.OneOf("*")),
+				},
+			},
+			"group": schema.StringAttribute{
+				Description: "Multicast group address of the route.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ipAddressValidator{multicast: true},
+				},
+			},
+			"sinks": schema.SetAttribute{
+				Description: "Connect points receiving the group traffic, e.g. of:0000000000000001/3.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(connectPointValidator),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the route.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mcastRouteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *mcastRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mcastRouteResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route := onosMcastRoute{Source: plan.Source.ValueString(), Group: plan.Group.ValueString()}
+	err := onosRequest(ctx, r.client, "POST", "/mcast", route, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating multicast route",
+			"Could not create multicast route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncSinks(ctx, plan, types.SetNull(types.StringType))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(route.Source + "/" + route.Group)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mcastRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mcastRouteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, found, err := getMcastRoute(ctx, r.client, state.Source.ValueString(), state.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Multicast Route",
+			"Could not read multicast route "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Sinks, diags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(route.Sinks))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update adds and removes sinks in place.
+func (r *mcastRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mcastRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncSinks(ctx, plan, state.Sinks)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *mcastRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mcastRouteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route := onosMcastRoute{Source: state.Source.ValueString(), Group: state.Group.ValueString()}
+	err := onosRequest(ctx, r.client, "DELETE", "/mcast", route, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos multicast route",
+			"Could not delete multicast route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *mcastRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Pass in the source and group, e.g. terraform import onos_mcast_route.iptv "10.0.0.1/224.1.1.1"
+	source, group, ok := strings.Cut(req.ID, "/")
+	if !ok || source == "" || group == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Source/Group. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source"), source)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), group)...)
+}
+
+// syncSinks adds the planned sinks missing from previous and removes the
+// sinks no longer planned.
+func (r *mcastRouteResource) syncSinks(ctx context.Context, plan mcastRouteResourceModel, previous types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var planned, current []string
+	diags.Append(plan.Sinks.ElementsAs(ctx, &planned, false)...)
+	if !previous.IsNull() && !previous.IsUnknown() {
+		diags.Append(previous.ElementsAs(ctx, &current, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	sinksPath := mcastSinksPath(plan.Source.ValueString(), plan.Group.ValueString())
+	added, removed := diffStrings(current, planned)
+	if len(removed) > 0 {
+		err := onosRequest(ctx, r.client, "DELETE", sinksPath, onosMcastSinks{Sinks: removed}, nil)
+		if err != nil {
+			diags.AddError(
+				"Error removing multicast sinks",
+				"Could not remove sinks from multicast route, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	if len(added) > 0 {
+		err := onosRequest(ctx, r.client, "POST", sinksPath, onosMcastSinks{Sinks: added}, nil)
+		if err != nil {
+			diags.AddError(
+				"Error adding multicast sinks",
+				"Could not add sinks to multicast route, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	return diags
+}
+
+func mcastSinksPath(source, group string) string {
+	return "/mcast/sinks/" + url.PathEscape(group) + "/" + url.PathEscape(source)
+}
+
+// getMcastRoute looks up a route by source and group.
+func getMcastRoute(ctx context.Context, client *onosclient.Client, source, group string) (onosMcastRoute, bool, error) {
+	var routes onosMcastRoutes
+	err := onosRequest(ctx, client, "GET", "/mcast", nil, &routes)
+	if err != nil {
+		return onosMcastRoute{}, false, err
+	}
+	for _, route := range routes.Routes {
+		if route.Source == source && route.Group == group {
+			return route, true, nil
+		}
+	}
+	return onosMcastRoute{}, false, nil
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccMcastRouteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_mcast_route" "test" {
+					source = "10.0.0.1"
+					group  = "224.1.1.1"
+					sinks  = ["of:0000000000000001/3"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_mcast_route.test", "id", "10.0.0.1/224.1.1.1"),
+					resource.TestCheckResourceAttr("onos_mcast_route.test", "sinks.#", "1"),
+					resource.TestCheckResourceAttrSet("onos_mcast_route.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_mcast_route.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_mcast_route" "test" {
+					source = "10.0.0.1"
+					group  = "224.1.1.1"
+					sinks  = ["of:0000000000000002/3", "of:0000000000000003/3"]
+				  }
+
+				data "onos_mcast_routes" "test" {
+					depends_on = [onos_mcast_route.test]
+				  }
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("onos_mcast_route.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_mcast_route.test", "sinks.#", "2"),
+					resource.TestCheckTypeSetElemAttr("onos_mcast_route.test", "sinks.*", "of:0000000000000003/3"),
+					resource.TestCheckResourceAttr("data.onos_mcast_routes.test", "routes.#", "1"),
+					resource.TestCheckResourceAttr("data.onos_mcast_routes.test", "routes.0.sinks.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestSyncSinks(t *testing.T) {
+	sinks := func(values ...string) types.Set {
+		elements := []attr.Value{}
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	tests := []struct {
+		name         string
+		planned      types.Set
+		previous     types.Set
+		failStatus   int
+		wantRequests []string
+		wantError    bool
+	}{
+		{
+			name:         "create",
+			planned:      sinks("of:0000000000000002/1", "of:0000000000000003/1"),
+			previous:     types.SetNull(types.StringType),
+			wantRequests: []string{`POST {"sinks":["of:0000000000000002/1","of:0000000000000003/1"]}`},
+		},
+		{
+			name:     "add and remove",
+			planned:  sinks("of:0000000000000002/1", "of:0000000000000004/1"),
+			previous: sinks("of:0000000000000002/1", "of:0000000000000003/1"),
+			wantRequests: []string{
+				`DELETE {"sinks":["of:0000000000000003/1"]}`,
+				`POST {"sinks":["of:0000000000000004/1"]}`,
+			},
+		},
+		{
+			name:     "unchanged",
+			planned:  sinks("of:0000000000000002/1"),
+			previous: sinks("of:0000000000000002/1"),
+		},
+		{
+			// Sinks are not added when the removal failed.
+			name:         "remove failed",
+			planned:      sinks("of:0000000000000004/1"),
+			previous:     sinks("of:0000000000000003/1"),
+			failStatus:   http.StatusBadRequest,
+			wantRequests: []string{`DELETE {"sinks":["of:0000000000000003/1"]}`},
+			wantError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/onos/v1/mcast/sinks/224.0.1.1/10.0.0.1" {
+					t.Errorf("unexpected request path %s", r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				requests = append(requests, r.Method+" "+string(body))
+				if tt.failStatus != 0 {
+					w.WriteHeader(tt.failStatus)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+			client, err := onosclient.NewClient(server.URL+"/onos/v1", "onos", "rocks")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := &mcastRouteResource{client: client}
+			plan := mcastRouteResourceModel{
+				Source: types.StringValue("10.0.0.1"),
+				Group:  types.StringValue("224.0.1.1"),
+				Sinks:  tt.planned,
+			}
+			diags := r.syncSinks(context.Background(), plan, tt.previous)
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("expected error %t, got %v", tt.wantError, diags)
+			}
+			if !slices.Equal(requests, tt.wantRequests) {
+				t.Errorf("expected requests %q, got %q", tt.wantRequests, requests)
+			}
+		})
+	}
+}
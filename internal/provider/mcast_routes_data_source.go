@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &mcastRoutesDataSource{}
+	_ datasource.DataSourceWithConfigure = &mcastRoutesDataSource{}
+)
+
+// NewMcastRoutesDataSource is a helper function to simplify the provider implementation.
+func NewMcastRoutesDataSource() datasource.DataSource {
+	return &mcastRoutesDataSource{}
+}
+
+// mcastRoutesDataSource is the data source implementation.
+type mcastRoutesDataSource struct {
+	client *onosclient.Client
+}
+
+type mcastRoutesDataSourceModel struct {
+	Routes []mcastRouteModel `tfsdk:"routes"`
+}
+
+type mcastRouteModel struct {
+	Source types.String `tfsdk:"source"`
+	Group  types.String `tfsdk:"group"`
+	Sinks  types.Set    `tfsdk:"sinks"`
+}
+
+// Metadata returns the data source type name.
+func (d *mcastRoutesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcast_routes"
+}
+
+// Schema defines the schema for the data source.
+func (d *mcastRoutesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of multicast routes.",
+		Attributes: map[string]schema.Attribute{
+			"routes": schema.ListNestedAttribute{
+				Description: "List of multicast routes.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "Source IP address of the route, or * for any source.",
+							Computed:    true,
+						},
+						"group": schema.StringAttribute{
+							Description: "Multicast group address of the route.",
+							Computed:    true,
+						},
+						"sinks": schema.SetAttribute{
+							Description: "Connect points receiving the group traffic.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *mcastRoutesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *mcastRoutesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state mcastRoutesDataSourceModel
+
+	var routes onosMcastRoutes
+	err := onosRequest(ctx, d.client, "GET", "/mcast", nil, &routes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Multicast Routes",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Routes = []mcastRouteModel{}
+	for _, route := range routes.Routes {
+		sinks, diags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(route.Sinks))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Routes = append(state.Routes, mcastRouteModel{
+			Source: types.StringValue(route.Source),
+			Group:  types.StringValue(route.Group),
+			Sinks:  sinks,
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
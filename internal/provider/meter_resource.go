@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &meterResource{}
+	_ resource.ResourceWithConfigure   = &meterResource{}
+	_ resource.ResourceWithImportState = &meterResource{}
+)
+
+// meterResource is the resource implementation.
+type meterResource struct {
+	client *onosclient.Client
+}
+
+// NewMeterResource is a helper function to simplify the provider implementation.
+func NewMeterResource() resource.Resource {
+	return &meterResource{}
+}
+
+type meterResourceModel struct {
+	ID             types.String     `tfsdk:"id"`
+	DeviceID       types.String     `tfsdk:"device_id"`
+	MeterID        types.String     `tfsdk:"meter_id"`
+	Unit           types.String     `tfsdk:"unit"`
+	Burst          types.Bool       `tfsdk:"burst"`
+	Bands          []meterBandModel `tfsdk:"bands"`
+	AppID          types.String     `tfsdk:"app_id"`
+	State          types.String     `tfsdk:"state"`
+	Life           types.Int64      `tfsdk:"life"`
+	Packets        types.Int64      `tfsdk:"packets"`
+	Bytes          types.Int64      `tfsdk:"bytes"`
+	ReferenceCount types.Int64      `tfsdk:"reference_count"`
+	LastUpdated    types.String     `tfsdk:"last_updated"`
+}
+
+type meterBandModel struct {
+	Type      types.String `tfsdk:"type"`
+	Rate      types.Int64  `tfsdk:"rate"`
+	BurstSize types.Int64  `tfsdk:"burst_size"`
+	Prec      types.Int64  `tfsdk:"prec"`
+}
+
+// onosMeters maps the ONOS meter list response.
+type onosMeters struct {
+	Meters []onosMeter `json:"meters"`
+}
+
+// onosMeter maps an ONOS meter.
+type onosMeter struct {
+	ID             string          `json:"id,omitempty"`
+	AppID          string          `json:"appId,omitempty"`
+	DeviceID       string          `json:"deviceId"`
+	Unit           string          `json:"unit"`
+	Burst          bool            `json:"burst"`
+	State          string          `json:"state,omitempty"`
+	Life           int64           `json:"life,omitempty"`
+	Packets        int64           `json:"packets,omitempty"`
+	Bytes          int64           `json:"bytes,omitempty"`
+	ReferenceCount int64           `json:"referenceCount,omitempty"`
+	Bands          []onosMeterBand `json:"bands"`
+}
+
+// onosMeterBand maps a band of an ONOS meter.
+type onosMeterBand struct {
+	Type      string `json:"type"`
+	Rate      int64  `json:"rate"`
+	BurstSize int64  `json:"burstSize,omitempty"`
+	Prec      int64  `json:"prec,omitempty"`
+	Packets   int64  `json:"packets,omitempty"`
+	Bytes     int64  `json:"bytes,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *meterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meter"
+}
+
+// Schema defines the schema for the resource.
+func (r *meterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a meter on a device. ONOS meters cannot be modified, so any change replaces the meter.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the meter in the form device_id/meter_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device the meter is installed on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"meter_id": schema.StringAttribute{
+				Description: "ID of the meter assigned by ONOS. Reference it from METER treatment instructions.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Description: "Unit of the meter rates, KB_PER_SEC or PKTS_PER_SEC.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("KB_PER_SEC", "PKTS_PER_SEC"),
+				},
+			},
+			"burst": schema.BoolAttribute{
+				Description: "Whether the meter applies burst sizes. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"bands": schema.ListNestedAttribute{
+				Description: "Bands of the meter.",
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the band, DROP or REMARK.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("DROP", "REMARK"),
+							},
+						},
+						"rate": schema.Int64Attribute{
+							Description: "Rate of the band in the meter unit.",
+							Required:    true,
+						},
+						"burst_size": schema.Int64Attribute{
+							Description: "Burst size of the band.",
+							Optional:    true,
+						},
+						"prec": schema.Int64Attribute{
+							Description: "Precedence level increase for REMARK bands.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Description: "ID of the app that owns the meter.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the meter.",
+				Computed:    true,
+			},
+			"life": schema.Int64Attribute{
+				Description: "Number of seconds the meter has been installed.",
+				Computed:    true,
+			},
+			"packets": schema.Int64Attribute{
+				Description: "Number of packets processed by the meter.",
+				Computed:    true,
+			},
+			"bytes": schema.Int64Attribute{
+				Description: "Number of bytes processed by the meter.",
+				Computed:    true,
+			},
+			"reference_count": schema.Int64Attribute{
+				Description: "Number of flows referencing the meter.",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the meter.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *meterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *meterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan meterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceID := plan.DeviceID.ValueString()
+
+	// Create new meter; ONOS returns its location rather than the meter itself
+	headers, err := onosRequestWithHeaders(ctx, r.client, "POST", "/meters/"+url.PathEscape(deviceID), expandMeter(plan), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating meter",
+			"Could not create meter, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	meterID := locationID(headers)
+	if meterID == "" {
+		resp.Diagnostics.AddError(
+			"Error creating meter",
+			"Could not determine the ID of the created meter from the ONOS response.",
+		)
+		return
+	}
+
+	meter, err := getMeter(ctx, r.client, deviceID, meterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading meter",
+			"Could not read meter "+meterID+" after creating it, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	flattenMeter(meter, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *meterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state meterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed meter value from Onos
+	meter, err := getMeter(ctx, r.client, state.DeviceID.ValueString(), state.MeterID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Meter",
+			"Could not read meter "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite meter with refreshed state
+	flattenMeter(meter, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update refreshes the computed attributes; every configurable attribute
+// requires replacement.
+func (r *meterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan meterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	meter, err := getMeter(ctx, r.client, plan.DeviceID.ValueString(), plan.MeterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos Meter",
+			"Could not read meter "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	flattenMeter(meter, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *meterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state meterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing meter
+	err := onosRequest(ctx, r.client, "DELETE", meterPath(state.DeviceID.ValueString(), state.MeterID.ValueString()), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos meter",
+			"Could not delete meter, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *meterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Pass in the device id and meter id, e.g. terraform import onos_meter.limit "of:0000000000000001/1"
+	idx := strings.LastIndex(req.ID, "/")
+	if idx <= 0 || idx == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: DeviceID/MeterID. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("device_id"), req.ID[:idx])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("meter_id"), req.ID[idx+1:])...)
+}
+
+func meterPath(deviceID, meterID string) string {
+	return "/meters/" + url.PathEscape(deviceID) + "/" + url.PathEscape(meterID)
+}
+
+func getMeter(ctx context.Context, client *onosclient.Client, deviceID, meterID string) (onosMeter, error) {
+	var meter onosMeter
+	err := onosRequest(ctx, client, "GET", meterPath(deviceID, meterID), nil, &meter)
+	return meter, err
+}
+
+// expandMeter builds the ONOS meter request body from the resource model.
+func expandMeter(m meterResourceModel) onosMeter {
+	meter := onosMeter{
+		DeviceID: m.DeviceID.ValueString(),
+		Unit:     m.Unit.ValueString(),
+		Burst:    m.Burst.ValueBool(),
+		Bands:    []onosMeterBand{},
+	}
+	for _, band := range m.Bands {
+		meter.Bands = append(meter.Bands, onosMeterBand{
+			Type:      band.Type.ValueString(),
+			Rate:      band.Rate.ValueInt64(),
+			BurstSize: band.BurstSize.ValueInt64(),
+			Prec:      band.Prec.ValueInt64(),
+		})
+	}
+	return meter
+}
+
+// flattenMeter copies the ONOS meter into the resource model. Optional band
+// attributes keep their configured null value when ONOS reports them as zero.
+func flattenMeter(meter onosMeter, m *meterResourceModel) {
+	m.DeviceID = types.StringValue(meter.DeviceID)
+	m.MeterID = types.StringValue(meter.ID)
+	m.ID = types.StringValue(meter.DeviceID + "/" + meter.ID)
+	m.Unit = types.StringValue(meter.Unit)
+	m.Burst = types.BoolValue(meter.Burst)
+	m.AppID = types.StringValue(meter.AppID)
+	m.State = types.StringValue(meter.State)
+	m.Life = types.Int64Value(meter.Life)
+	m.Packets = types.Int64Value(meter.Packets)
+	m.Bytes = types.Int64Value(meter.Bytes)
+	m.ReferenceCount = types.Int64Value(meter.ReferenceCount)
+
+	bands := make([]meterBandModel, 0, len(meter.Bands))
+	for i, band := range meter.Bands {
+		b := meterBandModel{
+			Type:      types.StringValue(band.Type),
+			Rate:      types.Int64Value(band.Rate),
+			BurstSize: types.Int64Null(),
+			Prec:      types.Int64Null(),
+		}
+		if band.BurstSize != 0 || (i < len(m.Bands) && !m.Bands[i].BurstSize.IsNull()) {
+			b.BurstSize = types.Int64Value(band.BurstSize)
+		}
+		if band.Prec != 0 || (i < len(m.Bands) && !m.Bands[i].Prec.IsNull()) {
+			b.Prec = types.Int64Value(band.Prec)
+		}
+		bands = append(bands, b)
+	}
+	m.Bands = bands
+}
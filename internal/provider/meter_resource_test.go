@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMeterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_meter" "test" {
+					device_id = "of:0000000000000001"
+					unit      = "KB_PER_SEC"
+					burst     = true
+					bands = [
+					  {
+						type       = "DROP"
+						rate       = 1000
+						burst_size = 100
+					  },
+					]
+				  }
+
+				data "onos_meters" "test" {
+					device_id  = onos_meter.test.device_id
+					depends_on = [onos_meter.test]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_meter.test", "device_id", "of:0000000000000001"),
+					resource.TestCheckResourceAttr("onos_meter.test", "unit", "KB_PER_SEC"),
+					resource.TestCheckResourceAttr("onos_meter.test", "burst", "true"),
+					resource.TestCheckResourceAttr("onos_meter.test", "bands.#", "1"),
+					resource.TestCheckResourceAttr("onos_meter.test", "bands.0.type", "DROP"),
+					resource.TestCheckResourceAttr("onos_meter.test", "bands.0.rate", "1000"),
+					resource.TestCheckResourceAttr("onos_meter.test", "bands.0.burst_size", "100"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("onos_meter.test", "id"),
+					resource.TestCheckResourceAttrSet("onos_meter.test", "meter_id"),
+					resource.TestCheckResourceAttrSet("onos_meter.test", "state"),
+					resource.TestCheckResourceAttrSet("onos_meter.test", "last_updated"),
+					resource.TestCheckResourceAttrSet("data.onos_meters.test", "meters.0.id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onos_meter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the onos
+				// API, and the counters change between reads.
+				ImportStateVerifyIgnore: []string{"last_updated", "life", "packets", "bytes"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestExpandMeter(t *testing.T) {
+	tests := []struct {
+		name  string
+		model meterResourceModel
+		want  onosMeter
+	}{
+		{
+			name: "drop band",
+			model: meterResourceModel{
+				DeviceID: types.StringValue("of:0000000000000001"),
+				Unit:     types.StringValue("KB_PER_SEC"),
+				Burst:    types.BoolValue(true),
+				Bands: []meterBandModel{
+					{Type: types.StringValue("DROP"), Rate: types.Int64Value(1000), BurstSize: types.Int64Value(100), Prec: types.Int64Null()},
+				},
+			},
+			want: onosMeter{
+				DeviceID: "of:0000000000000001",
+				Unit:     "KB_PER_SEC",
+				Burst:    true,
+				Bands:    []onosMeterBand{{Type: "DROP", Rate: 1000, BurstSize: 100}},
+			},
+		},
+		{
+			// ONOS rejects a meter without a bands array.
+			name: "no bands",
+			model: meterResourceModel{
+				DeviceID: types.StringValue("of:0000000000000001"),
+				Unit:     types.StringValue("PKTS_PER_SEC"),
+				Burst:    types.BoolNull(),
+			},
+			want: onosMeter{
+				DeviceID: "of:0000000000000001",
+				Unit:     "PKTS_PER_SEC",
+				Bands:    []onosMeterBand{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expandMeter(tt.model); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFlattenMeter(t *testing.T) {
+	meter := onosMeter{
+		ID:             "1",
+		AppID:          "org.onosproject.rest",
+		DeviceID:       "of:0000000000000001",
+		Unit:           "KB_PER_SEC",
+		Burst:          true,
+		State:          "ADDED",
+		Life:           12,
+		Packets:        34,
+		Bytes:          5678,
+		ReferenceCount: 1,
+		Bands: []onosMeterBand{
+			{Type: "DROP", Rate: 1000},
+			{Type: "REMARK", Rate: 2000, BurstSize: 200, Prec: 1},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		bands     []meterBandModel
+		wantBands []meterBandModel
+	}{
+		{
+			name: "import",
+			wantBands: []meterBandModel{
+				{Type: types.StringValue("DROP"), Rate: types.Int64Value(1000), BurstSize: types.Int64Null(), Prec: types.Int64Null()},
+				{Type: types.StringValue("REMARK"), Rate: types.Int64Value(2000), BurstSize: types.Int64Value(200), Prec: types.Int64Value(1)},
+			},
+		},
+		{
+			// A configured zero stays zero instead of turning null.
+			name: "configured zero",
+			bands: []meterBandModel{
+				{Type: types.StringValue("DROP"), Rate: types.Int64Value(1000), BurstSize: types.Int64Value(0), Prec: types.Int64Null()},
+			},
+			wantBands: []meterBandModel{
+				{Type: types.StringValue("DROP"), Rate: types.Int64Value(1000), BurstSize: types.Int64Value(0), Prec: types.Int64Null()},
+				{Type: types.StringValue("REMARK"), Rate: types.Int64Value(2000), BurstSize: types.Int64Value(200), Prec: types.Int64Value(1)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := meterResourceModel{Bands: tt.bands}
+			flattenMeter(meter, &m)
+			if !reflect.DeepEqual(m.Bands, tt.wantBands) {
+				t.Errorf("expected bands %+v, got %+v", tt.wantBands, m.Bands)
+			}
+			if m.ID.ValueString() != "of:0000000000000001/1" {
+				t.Errorf("expected id of:0000000000000001/1, got %s", m.ID)
+			}
+			if m.State.ValueString() != "ADDED" || m.Bytes.ValueInt64() != 5678 || m.ReferenceCount.ValueInt64() != 1 {
+				t.Errorf("expected the meter counters to be copied, got %+v", m)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &metersDataSource{}
+	_ datasource.DataSourceWithConfigure = &metersDataSource{}
+)
+
+// NewMetersDataSource is a helper function to simplify the provider implementation.
+func NewMetersDataSource() datasource.DataSource {
+	return &metersDataSource{}
+}
+
+// metersDataSource is the data source implementation.
+type metersDataSource struct {
+	client *onosclient.Client
+}
+
+type metersDataSourceModel struct {
+	DeviceID types.String  `tfsdk:"device_id"`
+	Meters   []metersModel `tfsdk:"meters"`
+}
+
+type metersModel struct {
+	ID             types.String      `tfsdk:"id"`
+	DeviceID       types.String      `tfsdk:"device_id"`
+	AppID          types.String      `tfsdk:"app_id"`
+	Unit           types.String      `tfsdk:"unit"`
+	Burst          types.Bool        `tfsdk:"burst"`
+	State          types.String      `tfsdk:"state"`
+	Life           types.Int64       `tfsdk:"life"`
+	Packets        types.Int64       `tfsdk:"packets"`
+	Bytes          types.Int64       `tfsdk:"bytes"`
+	ReferenceCount types.Int64       `tfsdk:"reference_count"`
+	Bands          []metersBandModel `tfsdk:"bands"`
+}
+
+type metersBandModel struct {
+	Type      types.String `tfsdk:"type"`
+	Rate      types.Int64  `tfsdk:"rate"`
+	BurstSize types.Int64  `tfsdk:"burst_size"`
+	Prec      types.Int64  `tfsdk:"prec"`
+	Packets   types.Int64  `tfsdk:"packets"`
+	Bytes     types.Int64  `tfsdk:"bytes"`
+}
+
+// Metadata returns the data source type name.
+func (d *metersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meters"
+}
+
+// Schema defines the schema for the data source.
+func (d *metersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of meters.",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Description: "Only return the meters of this device.",
+				Optional:    true,
+			},
+			"meters": schema.ListNestedAttribute{
+				Description: "List of meters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the meter.",
+							Computed:    true,
+						},
+						"device_id": schema.StringAttribute{
+							Description: "ID of the device the meter is installed on.",
+							Computed:    true,
+						},
+						"app_id": schema.StringAttribute{
+							Description: "ID of the app that owns the meter.",
+							Computed:    true,
+						},
+						"unit": schema.StringAttribute{
+							Description: "Unit of the meter rates.",
+							Computed:    true,
+						},
+						"burst": schema.BoolAttribute{
+							Description: "Whether the meter applies burst sizes.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of the meter.",
+							Computed:    true,
+						},
+						"life": schema.Int64Attribute{
+							Description: "Number of seconds the meter has been installed.",
+							Computed:    true,
+						},
+						"packets": schema.Int64Attribute{
+							Description: "Number of packets processed by the meter.",
+							Computed:    true,
+						},
+						"bytes": schema.Int64Attribute{
+							Description: "Number of bytes processed by the meter.",
+							Computed:    true,
+						},
+						"reference_count": schema.Int64Attribute{
+							Description: "Number of flows referencing the meter.",
+							Computed:    true,
+						},
+						"bands": schema.ListNestedAttribute{
+							Description: "Bands of the meter.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Type of the band.",
+										Computed:    true,
+									},
+									"rate": schema.Int64Attribute{
+										Description: "Rate of the band.",
+										Computed:    true,
+									},
+									"burst_size": schema.Int64Attribute{
+										Description: "Burst size of the band.",
+										Computed:    true,
+									},
+									"prec": schema.Int64Attribute{
+										Description: "Precedence level of the band.",
+										Computed:    true,
+									},
+									"packets": schema.Int64Attribute{
+										Description: "Number of packets that hit the band.",
+										Computed:    true,
+									},
+									"bytes": schema.Int64Attribute{
+										Description: "Number of bytes that hit the band.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *metersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *metersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state metersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/meters"
+	if !state.DeviceID.IsNull() {
+		endpoint += "/" + url.PathEscape(state.DeviceID.ValueString())
+	}
+
+	var meters onosMeters
+	err := onosRequest(ctx, d.client, "GET", endpoint, nil, &meters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Meters",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Meters = []metersModel{}
+	for _, meter := range meters.Meters {
+		meterState := metersModel{
+			ID:             types.StringValue(meter.ID),
+			DeviceID:       types.StringValue(meter.DeviceID),
+			AppID:          types.StringValue(meter.AppID),
+			Unit:           types.StringValue(meter.Unit),
+			Burst:          types.BoolValue(meter.Burst),
+			State:          types.StringValue(meter.State),
+			Life:           types.Int64Value(meter.Life),
+			Packets:        types.Int64Value(meter.Packets),
+			Bytes:          types.Int64Value(meter.Bytes),
+			ReferenceCount: types.Int64Value(meter.ReferenceCount),
+			Bands:          []metersBandModel{},
+		}
+		for _, band := range meter.Bands {
+			meterState.Bands = append(meterState.Bands, metersBandModel{
+				Type:      types.StringValue(band.Type),
+				Rate:      types.Int64Value(band.Rate),
+				BurstSize: types.Int64Value(band.BurstSize),
+				Prec:      types.Int64Value(band.Prec),
+				Packets:   types.Int64Value(band.Packets),
+				Bytes:     types.Int64Value(band.Bytes),
+			})
+		}
+		state.Meters = append(state.Meters, meterState)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
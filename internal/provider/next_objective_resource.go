@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &nextObjectiveResource{}
+	_ resource.ResourceWithConfigure = &nextObjectiveResource{}
+)
+
+// nextObjectiveResource is the resource implementation.
+type nextObjectiveResource struct {
+	client *onosclient.Client
+}
+
+// NewNextObjectiveResource is a helper function to simplify the provider implementation.
+func NewNextObjectiveResource() resource.Resource {
+	return &nextObjectiveResource{}
+}
+
+type nextObjectiveResourceModel struct {
+	ID          types.String              `tfsdk:"id"`
+	DeviceID    types.String              `tfsdk:"device_id"`
+	AppID       types.String              `tfsdk:"app_id"`
+	NextID      types.Int64               `tfsdk:"next_id"`
+	Type        types.String              `tfsdk:"type"`
+	Treatments  []objectiveTreatmentModel `tfsdk:"treatments"`
+	Meta        *objectiveSelectorModel   `tfsdk:"meta"`
+	LastUpdated types.String              `tfsdk:"last_updated"`
+}
+
+// onosNextID maps the ONOS response when reserving a next objective ID.
+type onosNextID struct {
+	NextID int64 `json:"nextId"`
+}
+
+// Metadata returns the resource type name.
+func (r *nextObjectiveResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_next_objective"
+}
+
+// Schema defines the schema for the resource.
+func (r *nextObjectiveResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a next objective on a device, the group of treatments a forwarding objective hands traffic to. " +
+			"ONOS does not expose installed objectives for reading, so the state reflects the last applied configuration and any change replaces the objective.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the next objective in the form device_id/next_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device the objective is installed on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Description: "ID of the app that owns the objective. Defaults to org.onosproject.rest.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("org.onosproject.rest"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_id": schema.Int64Attribute{
+				Description: "ID of the next objective. A new ID is reserved from ONOS when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the next objective, SIMPLE, HASHED, BROADCAST or FAILOVER.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("SIMPLE", "HASHED", "BROADCAST", "FAILOVER"),
+				},
+			},
+			"treatments": schema.ListNestedAttribute{
+				Description: "Treatments of the next objective.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: objectiveTreatmentAttributes(),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"meta": schema.SingleNestedAttribute{
+				Description: "Selector passed to the pipeline as a hint for the treatments.",
+				Optional:    true,
+				Attributes:  objectiveSelectorAttributes(),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the objective.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *nextObjectiveResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *nextObjectiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan nextObjectiveResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reserve a next ID unless one was configured
+	if plan.NextID.IsUnknown() || plan.NextID.IsNull() {
+		var nextID onosNextID
+		err := onosRequest(ctx, r.client, "GET", "/flowobjectives/next", nil, &nextID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating next objective",
+				"Could not reserve a next objective ID, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		plan.NextID = types.Int64Value(nextID.NextID)
+	}
+
+	err := onosRequest(ctx, r.client, "POST", nextObjectivePath(plan.DeviceID.ValueString()), expandNextObjective(plan, "ADD"), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating next objective",
+			"Could not create next objective, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%d", plan.DeviceID.ValueString(), plan.NextID.ValueInt64()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the existing state, as ONOS does not return installed objectives.
+func (r *nextObjectiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nextObjectiveResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only refreshes the timestamp; every configurable attribute requires
+// replacement.
+func (r *nextObjectiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan nextObjectiveResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the objective by sending it again with the REMOVE operation.
+func (r *nextObjectiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state nextObjectiveResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", nextObjectivePath(state.DeviceID.ValueString()), expandNextObjective(state, "REMOVE"), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos next objective",
+			"Could not delete next objective, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func nextObjectivePath(deviceID string) string {
+	return "/flowobjectives/" + url.PathEscape(deviceID) + "/next"
+}
+
+// expandNextObjective builds the ONOS next objective JSON for the given
+// operation.
+func expandNextObjective(m nextObjectiveResourceModel, operation string) map[string]any {
+	treatments := []map[string]any{}
+	for i := range m.Treatments {
+		treatments = append(treatments, expandObjectiveTreatment(&m.Treatments[i]))
+	}
+	objective := map[string]any{
+		"id":          m.NextID.ValueInt64(),
+		"appId":       m.AppID.ValueString(),
+		"type":        m.Type.ValueString(),
+		"operation":   operation,
+		"isPermanent": true,
+		"treatments":  treatments,
+	}
+	if m.Meta != nil {
+		objective["meta"] = expandObjectiveSelector(m.Meta)
+	}
+	return objective
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+)
+
+// onosAPIError is returned when the ONOS REST API answers with a non-success
+// status code.
+type onosAPIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *onosAPIError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// isNotFound reports whether err is an ONOS API 404 response.
+func isNotFound(err error) bool {
+	var apiErr *onosAPIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// onosRequest sends a request to the ONOS REST API endpoints not covered by
+// onosclient, reusing the connection settings of the configured client. The
+// path is relative to the client host URL. When in is non-nil it is encoded
+// as the JSON request body, and when out is non-nil the response body is
+// decoded into it.
+func onosRequest(ctx context.Context, client *onosclient.Client, method, path string, in, out any) error {
+	_, err := onosRequestWithHeaders(ctx, client, method, path, in, out)
+	return err
+}
+
+// onosRequestWithHeaders behaves like onosRequest and also returns the
+// response headers, e.g. to read the Location of a newly created object.
+func onosRequestWithHeaders(ctx context.Context, client *onosclient.Client, method, path string, in, out any) (http.Header, error) {
+	return sendRequest(ctx, client, method, strings.TrimSuffix(client.HostURL, "/")+path, in, out)
+}
+
+// onosAppRequest behaves like onosRequest for the REST API of an ONOS app,
+// which is served next to the core API, e.g. /onos/segmentrouting next to
+// /onos/v1. The path is relative to the app API.
+func onosAppRequest(ctx context.Context, client *onosclient.Client, app, method, path string, in, out any) error {
+	base := strings.TrimSuffix(client.HostURL, "/")
+	if i := strings.LastIndex(base, "/"); i >= 0 {
+		base = base[:i]
+	}
+	_, err := sendRequest(ctx, client, method, base+"/"+app+path, in, out)
+	return err
+}
+
+// sendRequest sends a JSON request to an absolute ONOS URL using the
+// connection settings and credentials of the configured client.
+func sendRequest(ctx context.Context, client *onosclient.Client, method, endpoint string, in, out any) (http.Header, error) {
+	var reqBody io.Reader
+	if in != nil {
+		rb, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(rb)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.SetBasicAuth(client.Username, client.Password)
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusNoContent {
+		return nil, &onosAPIError{StatusCode: res.StatusCode, Body: string(body)}
+	}
+
+	if out != nil && len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return res.Header, nil
+}
+
+// locationID returns the last path segment of the Location header ONOS sets
+// on newly created objects, or an empty string when it is missing.
+func locationID(headers http.Header) string {
+	location := headers.Get("Location")
+	if location == "" {
+		return ""
+	}
+	return path.Base(location)
+}
+
+// netcfgPath returns the network configuration path of a subject config key,
+// e.g. /network/configuration/devices/of:0000000000000001/basic.
+func netcfgPath(subjectClass, subject, configKey string) string {
+	return netcfgSubjectPath(subjectClass, subject) + "/" + url.PathEscape(configKey)
+}
+
+// netcfgSubjectPath returns the API path of the configs of a subject, e.g.
+// the configs of a port. ONOS only accepts JSON objects below this level, so
+// array configs such as the interfaces of a port are posted here.
+func netcfgSubjectPath(subjectClass, subject string) string {
+	return "/network/configuration/" + url.PathEscape(subjectClass) + "/" + url.PathEscape(subject)
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &packetProcessorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &packetProcessorsDataSource{}
+)
+
+// NewPacketProcessorsDataSource is a helper function to simplify the provider implementation.
+func NewPacketProcessorsDataSource() datasource.DataSource {
+	return &packetProcessorsDataSource{}
+}
+
+// packetProcessorsDataSource is the data source implementation.
+type packetProcessorsDataSource struct {
+	client  *onosclient.Client
+	version onosVersion
+}
+
+type packetProcessorsDataSourceModel struct {
+	Processors []packetProcessorModel `tfsdk:"processors"`
+}
+
+type packetProcessorModel struct {
+	Processor  types.String `tfsdk:"processor"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Type       types.String `tfsdk:"type"`
+	Packets    types.Int64  `tfsdk:"packets"`
+	AvgNanos   types.Int64  `tfsdk:"avg_nanos"`
+	TotalNanos types.Int64  `tfsdk:"total_nanos"`
+}
+
+// onosPacketProcessors maps the ONOS packet processors response.
+type onosPacketProcessors struct {
+	Processors []onosPacketProcessor `json:"packet-processors"`
+}
+
+// onosPacketProcessor maps a packet processor registered with the packet
+// service. ONOS only reports the average processing time of a processor.
+type onosPacketProcessor struct {
+	Processor string `json:"processor"`
+	Priority  int64  `json:"priority"`
+	Type      string `json:"type"`
+	Packets   int64  `json:"packets"`
+	AvgNanos  int64  `json:"avgNanos"`
+}
+
+// Metadata returns the data source type name.
+func (d *packetProcessorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_packet_processors"
+}
+
+// Schema defines the schema for the data source.
+func (d *packetProcessorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the packet processors handling the packets sent to the controller, in processing order. Requires ONOS 2.1 or later.",
+		Attributes: map[string]schema.Attribute{
+			"processors": schema.ListNestedAttribute{
+				Description: "List of packet processors.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"processor": schema.StringAttribute{
+							Description: "Class name of the processor, which identifies the app it belongs to.",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Priority of the processor, lower priorities process packets first.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the processor derived from its priority: ADVISOR, DIRECTOR or OBSERVER.",
+							Computed:    true,
+						},
+						"packets": schema.Int64Attribute{
+							Description: "Number of packets processed.",
+							Computed:    true,
+						},
+						"avg_nanos": schema.Int64Attribute{
+							Description: "Average processing time of a packet in nanoseconds.",
+							Computed:    true,
+						},
+						"total_nanos": schema.Int64Attribute{
+							Description: "Total processing time in nanoseconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *packetProcessorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+	d.version = data.Version
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *packetProcessorsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state packetProcessorsDataSourceModel
+
+	resp.Diagnostics.Append(checkFeature(d.version, featurePacketProcessors)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var processors onosPacketProcessors
+	err := onosRequest(ctx, d.client, "GET", "/packet/processors", nil, &processors)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Packet Processors",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Processors = []packetProcessorModel{}
+	for _, processor := range processors.Processors {
+		state.Processors = append(state.Processors, flattenPacketProcessor(processor))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flattenPacketProcessor converts an ONOS packet processor to the data source
+// model. The total processing time is derived from the average one.
+func flattenPacketProcessor(processor onosPacketProcessor) packetProcessorModel {
+	return packetProcessorModel{
+		Processor:  types.StringValue(processor.Processor),
+		Priority:   types.Int64Value(processor.Priority),
+		Type:       types.StringValue(processor.Type),
+		Packets:    types.Int64Value(processor.Packets),
+		AvgNanos:   types.Int64Value(processor.AvgNanos),
+		TotalNanos: types.Int64Value(processor.AvgNanos * processor.Packets),
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPacketProcessorsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "onos_packet_processors" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.onos_packet_processors.test", "processors.0.processor"),
+					resource.TestCheckResourceAttrSet("data.onos_packet_processors.test", "processors.0.type"),
+					resource.TestCheckResourceAttrSet("data.onos_packet_processors.test", "processors.0.packets"),
+					resource.TestCheckResourceAttrSet("data.onos_packet_processors.test", "processors.0.total_nanos"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenPacketProcessor(t *testing.T) {
+	body := `{"packet-processors": [
+		{"processor": "org.onosproject.fwd.ReactiveForwarding$ReactivePacketProcessor", "priority": 2, "type": "ADVISOR", "packets": 0, "avgNanos": 0},
+		{"processor": "org.onosproject.provider.lldp.impl.LldpLinkProvider$InternalPacketProcessor", "priority": 0, "type": "DIRECTOR", "packets": 1200, "avgNanos": 35000}
+	]}`
+	var processors onosPacketProcessors
+	if err := json.Unmarshal([]byte(body), &processors); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []packetProcessorModel{
+		{
+			Processor:  types.StringValue("org.onosproject.fwd.ReactiveForwarding$ReactivePacketProcessor"),
+			Priority:   types.Int64Value(2),
+			Type:       types.StringValue("ADVISOR"),
+			Packets:    types.Int64Value(0),
+			AvgNanos:   types.Int64Value(0),
+			TotalNanos: types.Int64Value(0),
+		},
+		{
+			Processor:  types.StringValue("org.onosproject.provider.lldp.impl.LldpLinkProvider$InternalPacketProcessor"),
+			Priority:   types.Int64Value(0),
+			Type:       types.StringValue("DIRECTOR"),
+			Packets:    types.Int64Value(1200),
+			AvgNanos:   types.Int64Value(35000),
+			TotalNanos: types.Int64Value(42000000),
+		},
+	}
+	got := []packetProcessorModel{}
+	for _, processor := range processors.Processors {
+		got = append(got, flattenPacketProcessor(processor))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &portStatisticsDataSource{}
+	_ datasource.DataSourceWithConfigure = &portStatisticsDataSource{}
+)
+
+// NewPortStatisticsDataSource is a helper function to simplify the provider implementation.
+func NewPortStatisticsDataSource() datasource.DataSource {
+	return &portStatisticsDataSource{}
+}
+
+// portStatisticsDataSource is the data source implementation.
+type portStatisticsDataSource struct {
+	client  *onosclient.Client
+	version onosVersion
+}
+
+type portStatisticsDataSourceModel struct {
+	DeviceID types.String          `tfsdk:"device_id"`
+	Port     types.String          `tfsdk:"port"`
+	Delta    types.Bool            `tfsdk:"delta"`
+	Ports    []portStatisticsModel `tfsdk:"ports"`
+}
+
+type portStatisticsModel struct {
+	DeviceID         types.String `tfsdk:"device_id"`
+	Port             types.String `tfsdk:"port"`
+	PacketsReceived  types.Int64  `tfsdk:"packets_received"`
+	PacketsSent      types.Int64  `tfsdk:"packets_sent"`
+	BytesReceived    types.Int64  `tfsdk:"bytes_received"`
+	BytesSent        types.Int64  `tfsdk:"bytes_sent"`
+	PacketsRxDropped types.Int64  `tfsdk:"packets_rx_dropped"`
+	PacketsTxDropped types.Int64  `tfsdk:"packets_tx_dropped"`
+	PacketsRxErrors  types.Int64  `tfsdk:"packets_rx_errors"`
+	PacketsTxErrors  types.Int64  `tfsdk:"packets_tx_errors"`
+	DurationSec      types.Int64  `tfsdk:"duration_sec"`
+}
+
+// onosPortStatistics maps the ONOS port statistics response.
+type onosPortStatistics struct {
+	Statistics []onosDevicePortStatistics `json:"statistics"`
+}
+
+// onosDevicePortStatistics maps the port statistics of one device.
+type onosDevicePortStatistics struct {
+	Device string              `json:"device"`
+	Ports  []onosPortStatistic `json:"ports"`
+}
+
+// onosPortStatistic maps the counters of one port.
+type onosPortStatistic struct {
+	Port             int64 `json:"port"`
+	PacketsReceived  int64 `json:"packetsReceived"`
+	PacketsSent      int64 `json:"packetsSent"`
+	BytesReceived    int64 `json:"bytesReceived"`
+	BytesSent        int64 `json:"bytesSent"`
+	PacketsRxDropped int64 `json:"packetsRxDropped"`
+	PacketsTxDropped int64 `json:"packetsTxDropped"`
+	PacketsRxErrors  int64 `json:"packetsRxErrors"`
+	PacketsTxErrors  int64 `json:"packetsTxErrors"`
+	DurationSec      int64 `json:"durationSec"`
+}
+
+// Metadata returns the data source type name.
+func (d *portStatisticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_port_statistics"
+}
+
+// Schema defines the schema for the data source.
+func (d *portStatisticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the port counters of the devices.",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Description: "Only return the ports of this device.",
+				Optional:    true,
+			},
+			"port": schema.StringAttribute{
+				Description: "Only return this port number. Requires device_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("device_id")),
+				},
+			},
+			"delta": schema.BoolAttribute{
+				Description: "Return the counters accumulated since the previous statistics poll of ONOS instead of the totals. Requires ONOS 2.0 or later.",
+				Optional:    true,
+			},
+			"ports": schema.ListNestedAttribute{
+				Description: "Counters per device port.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.StringAttribute{
+							Description: "ID of the device.",
+							Computed:    true,
+						},
+						"port": schema.StringAttribute{
+							Description: "Port number.",
+							Computed:    true,
+						},
+						"packets_received": schema.Int64Attribute{
+							Description: "Number of packets received.",
+							Computed:    true,
+						},
+						"packets_sent": schema.Int64Attribute{
+							Description: "Number of packets sent.",
+							Computed:    true,
+						},
+						"bytes_received": schema.Int64Attribute{
+							Description: "Number of bytes received.",
+							Computed:    true,
+						},
+						"bytes_sent": schema.Int64Attribute{
+							Description: "Number of bytes sent.",
+							Computed:    true,
+						},
+						"packets_rx_dropped": schema.Int64Attribute{
+							Description: "Number of received packets dropped.",
+							Computed:    true,
+						},
+						"packets_tx_dropped": schema.Int64Attribute{
+							Description: "Number of packets dropped on transmission.",
+							Computed:    true,
+						},
+						"packets_rx_errors": schema.Int64Attribute{
+							Description: "Number of receive errors.",
+							Computed:    true,
+						},
+						"packets_tx_errors": schema.Int64Attribute{
+							Description: "Number of transmit errors.",
+							Computed:    true,
+						},
+						"duration_sec": schema.Int64Attribute{
+							Description: "Seconds the counters cover.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *portStatisticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+	d.version = data.Version
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *portStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state portStatisticsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Delta.ValueBool() {
+		resp.Diagnostics.Append(checkFeature(d.version, featureDeltaPortStatistics)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var statistics onosPortStatistics
+	endpoint := portStatisticsPath(state.Delta.ValueBool(), state.DeviceID.ValueString(), state.Port.ValueString())
+	err := onosRequest(ctx, d.client, "GET", endpoint, nil, &statistics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Port Statistics",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Ports = []portStatisticsModel{}
+	for _, device := range statistics.Statistics {
+		for _, port := range device.Ports {
+			state.Ports = append(state.Ports, portStatisticsModel{
+				DeviceID:         types.StringValue(device.Device),
+				Port:             types.StringValue(fmt.Sprint(port.Port)),
+				PacketsReceived:  types.Int64Value(port.PacketsReceived),
+				PacketsSent:      types.Int64Value(port.PacketsSent),
+				BytesReceived:    types.Int64Value(port.BytesReceived),
+				BytesSent:        types.Int64Value(port.BytesSent),
+				PacketsRxDropped: types.Int64Value(port.PacketsRxDropped),
+				PacketsTxDropped: types.Int64Value(port.PacketsTxDropped),
+				PacketsRxErrors:  types.Int64Value(port.PacketsRxErrors),
+				PacketsTxErrors:  types.Int64Value(port.PacketsTxErrors),
+				DurationSec:      types.Int64Value(port.DurationSec),
+			})
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// portStatisticsPath returns the API path of the port statistics, narrowed to
+// a device and one of its ports when they are not empty.
+func portStatisticsPath(delta bool, deviceID, port string) string {
+	endpoint := "/statistics/ports"
+	if delta {
+		endpoint = "/statistics/delta/ports"
+	}
+	if deviceID == "" {
+		return endpoint
+	}
+	endpoint += "/" + url.PathEscape(deviceID)
+	if port != "" {
+		endpoint += "/" + url.PathEscape(port)
+	}
+	return endpoint
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPortStatisticsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "onos_port_statistics" "test" {
+					device_id = "of:0000000000000001"
+					port      = "1"
+				  }
+
+				data "onos_port_statistics" "delta" {
+					device_id = "of:0000000000000001"
+					delta     = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onos_port_statistics.test", "ports.#", "1"),
+					resource.TestCheckResourceAttr("data.onos_port_statistics.test", "ports.0.device_id", "of:0000000000000001"),
+					resource.TestCheckResourceAttr("data.onos_port_statistics.test", "ports.0.port", "1"),
+					resource.TestCheckResourceAttrSet("data.onos_port_statistics.test", "ports.0.bytes_received"),
+					resource.TestCheckResourceAttrSet("data.onos_port_statistics.test", "ports.0.duration_sec"),
+					resource.TestCheckResourceAttrSet("data.onos_port_statistics.delta", "ports.0.packets_sent"),
+				),
+			},
+		},
+	})
+}
+
+func TestPortStatisticsPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		delta    bool
+		deviceID string
+		port     string
+		expected string
+	}{
+		{name: "all", expected: "/statistics/ports"},
+		{name: "delta", delta: true, expected: "/statistics/delta/ports"},
+		{name: "device", deviceID: "of:0000000000000001", expected: "/statistics/ports/of:0000000000000001"},
+		{name: "device port", deviceID: "of:0000000000000001", port: "1", expected: "/statistics/ports/of:0000000000000001/1"},
+		{name: "delta device port", delta: true, deviceID: "of:0000000000000001", port: "2", expected: "/statistics/delta/ports/of:0000000000000001/2"},
+		{name: "escaped", deviceID: "netconf:10.0.0.1/830", expected: "/statistics/ports/netconf:10.0.0.1%2F830"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := portStatisticsPath(tt.delta, tt.deviceID, tt.port)
+			if got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
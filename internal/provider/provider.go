@@ -2,14 +2,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,11 +30,41 @@ var (
 	_ provider.Provider = &onosProvider{}
 )
 
+// onosProviderData is passed to the Configure methods of the resources and
+// data sources.
+type onosProviderData struct {
+	Client *onosclient.Client
+	// Version is the ONOS release detected by the connectivity check, zero
+	// when the check is skipped or the version cannot be parsed.
+	Version onosVersion
+}
+
 // onosProviderModel maps provider schema data to a Go type.
 type onosProviderModel struct {
 	Host     types.String `tfsdk:"host"`
+	Hosts    types.List   `tfsdk:"hosts"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+
+	AuthMode      types.String `tfsdk:"auth_mode"`
+	Token         types.String `tfsdk:"token"`
+	TokenFile     types.String `tfsdk:"token_file"`
+	CustomHeaders types.Map    `tfsdk:"custom_headers"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	TLSServerName      types.String `tfsdk:"tls_server_name"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	RequestTimeout       types.String `tfsdk:"request_timeout"`
+	MaxRetries           types.Int64  `tfsdk:"max_retries"`
+	RetryBackoffMin      types.String `tfsdk:"retry_backoff_min"`
+	RetryBackoffMax      types.String `tfsdk:"retry_backoff_max"`
+	MaxRequestsPerSecond types.Int64  `tfsdk:"max_requests_per_second"`
+
+	SkipConnectivityCheck types.Bool `tfsdk:"skip_connectivity_check"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -58,14 +99,122 @@ func (p *onosProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Description: "URI for ONOS API. May also be provided via ONOS_HOST environment variable.",
 				Optional:    true,
 			},
+			"hosts": schema.ListAttribute{
+				Description: "URIs for the ONOS API of the nodes of an ONOS cluster, in order of preference. The provider uses the first node answering and fails over to the next nodes on connection errors. " +
+					"The URIs must only differ by scheme, address and port. May also be provided as a comma separated list via ONOS_HOSTS environment variable.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("host")),
+				},
+			},
 			"username": schema.StringAttribute{
-				Description: "Username for ONOS API. May also be provided via ONOS_USERNAME environment variable.",
+				Description: "Username for ONOS API, required when auth_mode is basic. May also be provided via ONOS_USERNAME environment variable.",
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for ONOS API. May also be provided via ONOS_PASSWORD environment variable.",
+				Description: "Password for ONOS API, required when auth_mode is basic. May also be provided via ONOS_PASSWORD environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"auth_mode": schema.StringAttribute{
+				Description: "Authentication of the ONOS API requests: basic with username and password, bearer with a token, or none, e.g. when a reverse proxy authenticates with custom_headers. " +
+					"Defaults to basic. May also be provided via ONOS_AUTH_MODE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(authModes...),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "Bearer token for ONOS API, required when auth_mode is bearer unless token_file is set. May also be provided via ONOS_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token_file")),
+				},
+			},
+			"token_file": schema.StringAttribute{
+				Description: "Path to a file holding the bearer token for ONOS API. May also be provided via ONOS_TOKEN_FILE environment variable.",
+				Optional:    true,
+			},
+			"custom_headers": schema.MapAttribute{
+				Description: "Headers added to every ONOS API request, e.g. for an authenticating reverse proxy. They replace the headers set by the provider, including Authorization.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM file of the CA certificates trusted to verify the ONOS API certificate. May also be provided via ONOS_CA_CERT_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates trusted to verify the ONOS API certificate. May also be provided via ONOS_CA_CERT_PEM environment variable.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM encoded client certificate, or path to a PEM file, for mutual TLS. May also be provided via ONOS_CLIENT_CERT environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate, or path to a PEM file. May also be provided via ONOS_CLIENT_KEY environment variable.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"tls_server_name": schema.StringAttribute{
+				Description: "Server name used to verify the ONOS API certificate when it differs from the host name. May also be provided via ONOS_TLS_SERVER_NAME environment variable.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the ONOS API certificate. Only use for testing. May also be provided via ONOS_INSECURE_SKIP_VERIFY environment variable.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout of each attempt of an ONOS API request, e.g. 30s. Defaults to 10s. May also be provided via ONOS_REQUEST_TIMEOUT environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of retries of the ONOS API requests failing with a 5xx or 429 status or a connection reset. POST requests, which are not idempotent, are only retried on a 429 status or when they could not be sent. Defaults to 3. May also be provided via ONOS_MAX_RETRIES environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_backoff_min": schema.StringAttribute{
+				Description: "Wait before the first retry, doubled on each following retry. Defaults to 1s. May also be provided via ONOS_RETRY_BACKOFF_MIN environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"retry_backoff_max": schema.StringAttribute{
+				Description: "Maximum wait between retries. Defaults to 30s. May also be provided via ONOS_RETRY_BACKOFF_MAX environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				Description: "Maximum number of ONOS API requests per second, unlimited when 0. Defaults to 0. May also be provided via ONOS_MAX_REQUESTS_PER_SECOND environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"skip_connectivity_check": schema.BoolAttribute{
+				Description: "Skip the check of the connection settings, credentials and ONOS version when the provider is configured. The ONOS release required by some resources and data sources is then not checked. Defaults to false. May also be provided via ONOS_SKIP_CONNECTIVITY_CHECK environment variable.",
+				Optional:    true,
 			},
 		},
 	}
@@ -112,6 +261,47 @@ func (p *onosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if config.CustomHeaders.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("custom_headers"),
+			"Unknown onos API Custom Headers",
+			"The provider cannot create the onos API client as there is an unknown configuration value for the custom headers. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"hosts", config.Hosts},
+		{"auth_mode", config.AuthMode},
+		{"token", config.Token},
+		{"token_file", config.TokenFile},
+		{"ca_cert_file", config.CACertFile},
+		{"ca_cert_pem", config.CACertPEM},
+		{"client_cert", config.ClientCert},
+		{"client_key", config.ClientKey},
+		{"tls_server_name", config.TLSServerName},
+		{"insecure_skip_verify", config.InsecureSkipVerify},
+		{"request_timeout", config.RequestTimeout},
+		{"max_retries", config.MaxRetries},
+		{"retry_backoff_min", config.RetryBackoffMin},
+		{"retry_backoff_max", config.RetryBackoffMax},
+		{"max_requests_per_second", config.MaxRequestsPerSecond},
+		{"skip_connectivity_check", config.SkipConnectivityCheck},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown onos API Setting",
+				"The provider cannot create the onos API client as there is an unknown configuration value for "+attribute.name+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the "+
+					"ONOS_"+strings.ToUpper(attribute.name)+" environment variable.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -120,11 +310,32 @@ func (p *onosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	// with Terraform configuration value if set.
 
 	host := os.Getenv("ONOS_HOST")
+	hosts := splitHosts(os.Getenv("ONOS_HOSTS"))
 	username := os.Getenv("ONOS_USERNAME")
 	password := os.Getenv("ONOS_PASSWORD")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
+		hosts = nil
+	}
+
+	if !config.Hosts.IsNull() {
+		hosts = nil
+		diags = config.Hosts.ElementsAs(ctx, &hosts, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if len(hosts) == 0 && host != "" {
+		hosts = []string{host}
+	}
+
+	authOpts, diags := authOptionsFromConfig(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !config.Username.IsNull() {
@@ -135,20 +346,65 @@ func (p *onosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		password = config.Password.ValueString()
 	}
 
+	tlsOpts := tlsOptions{
+		CACertFile: os.Getenv("ONOS_CA_CERT_FILE"),
+		CACertPEM:  os.Getenv("ONOS_CA_CERT_PEM"),
+		ClientCert: os.Getenv("ONOS_CLIENT_CERT"),
+		ClientKey:  os.Getenv("ONOS_CLIENT_KEY"),
+		ServerName: os.Getenv("ONOS_TLS_SERVER_NAME"),
+	}
+
+	tlsOpts.InsecureSkipVerify = envBool(&resp.Diagnostics, "insecure_skip_verify")
+	skipConnectivityCheck := envBool(&resp.Diagnostics, "skip_connectivity_check")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configuration values replace their environment variable, so a CA or
+	// client certificate from the configuration also replaces the one from
+	// the environment in the other form.
+	if !config.CACertFile.IsNull() || !config.CACertPEM.IsNull() {
+		tlsOpts.CACertFile = config.CACertFile.ValueString()
+		tlsOpts.CACertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.ClientCert.IsNull() {
+		tlsOpts.ClientCert = config.ClientCert.ValueString()
+		tlsOpts.ClientKey = config.ClientKey.ValueString()
+	}
+
+	if !config.TLSServerName.IsNull() {
+		tlsOpts.ServerName = config.TLSServerName.ValueString()
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		tlsOpts.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if !config.SkipConnectivityCheck.IsNull() {
+		skipConnectivityCheck = config.SkipConnectivityCheck.ValueBool()
+	}
+
+	transportOpts, diags := transportOptionsFromConfig(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if host == "" {
+	if len(hosts) == 0 {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing onos API Host",
 			"The provider cannot create the onos API client as there is a missing or empty value for the onos API host. "+
-				"Set the host value in the configuration or use the ONOS_HOST environment variable. "+
+				"Set the host or hosts value in the configuration or use the ONOS_HOST or ONOS_HOSTS environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if username == "" {
+	if authOpts.Mode == authModeBasic && username == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing onos API Username",
@@ -158,7 +414,7 @@ func (p *onosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
-	if password == "" {
+	if authOpts.Mode == authModeBasic && password == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing onos API Password",
@@ -168,11 +424,31 @@ func (p *onosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if authOpts.Mode == authModeBearer && authOpts.Token == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Missing onos API Token",
+			"The provider cannot create the onos API client as auth_mode is bearer and there is a missing or empty value for the onos API token. "+
+				"Set the token or token_file value in the configuration or use the ONOS_TOKEN or ONOS_TOKEN_FILE environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "onos_host", host)
+	nodes, err := parseNodes(hosts)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hosts"),
+			"Invalid onos API Hosts",
+			"The provider cannot create the onos API client as a host is invalid: "+err.Error(),
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "onos_host", strings.Join(hosts, ","))
 	ctx = tflog.SetField(ctx, "onos_username", username)
 	ctx = tflog.SetField(ctx, "onos_password", password)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "onos_password")
@@ -180,9 +456,8 @@ func (p *onosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	tflog.Debug(ctx, "Creating Onos client")
 
 	// Create a new Onos client using the configuration values
-	//client := &http.Client{Timeout: 10 * time.Second}
 
-	client, err := onosclient.NewClient(host, username, password)
+	client, err := onosclient.NewClient(hosts[0], username, password)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create ONOS API Client",
@@ -193,20 +468,257 @@ func (p *onosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	// Make the Onos client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	err = configureTLS(client.HTTPClient, tlsOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ONOS API TLS Configuration",
+			"The provider cannot create the ONOS API client as the TLS settings are invalid. "+
+				"Check the ca_cert_file, ca_cert_pem, client_cert and client_key values and their ONOS_ environment variables.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	configureAuth(client.HTTPClient, authOpts)
+
+	// Start with the first node of a cluster that is up, the failover
+	// transport takes over when it goes down later on.
+	current := 0
+	if len(nodes) > 1 {
+		probeCtx, cancel := context.WithTimeout(ctx, transportOpts.RequestTimeout)
+		current, err = selectNode(probeCtx, client.HTTPClient, nodes)
+		cancel()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hosts"),
+				"No Reachable ONOS Node",
+				"The provider cannot reach any of the ONOS cluster nodes. "+
+					"Check that at least one node is running and reachable from this machine.\n\n"+
+					err.Error(),
+			)
+			return
+		}
+		client.HostURL = hosts[current]
+		tflog.Info(ctx, "Selected ONOS cluster node", map[string]any{"onos_node": hosts[current]})
+	}
+	configureFailover(client.HTTPClient, nodes, current)
+
+	configureTransport(client.HTTPClient, transportOpts)
+
+	// Surface a misconfiguration here rather than as an error of the first
+	// data source or resource.
+	data := &onosProviderData{Client: client}
+	if !skipConnectivityCheck {
+		var diags diag.Diagnostics
+		data.Version, diags = checkConnectivity(ctx, client, authOpts.Mode)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.Version.known() {
+			tflog.Info(ctx, "Detected ONOS version", map[string]any{"onos_version": data.Version.String()})
+		}
+	}
+
+	// Make the Onos client and version available during DataSource and
+	// Resource type Configure methods.
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured Onos client", map[string]any{"success": true})
 
 }
 
+// authOptionsFromConfig returns the authentication options of the
+// configuration, defaulting to their environment variables. The token of a
+// token file is read right away.
+func authOptionsFromConfig(ctx context.Context, config onosProviderModel) (authOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := authOptions{
+		Mode:  os.Getenv("ONOS_AUTH_MODE"),
+		Token: os.Getenv("ONOS_TOKEN"),
+	}
+	tokenFile := os.Getenv("ONOS_TOKEN_FILE")
+
+	if !config.AuthMode.IsNull() {
+		opts.Mode = config.AuthMode.ValueString()
+	}
+
+	if opts.Mode == "" {
+		opts.Mode = authModeBasic
+	}
+
+	if !slices.Contains(authModes, opts.Mode) {
+		diags.AddAttributeError(
+			path.Root("auth_mode"),
+			"Invalid ONOS_AUTH_MODE Environment Variable",
+			"The ONOS_AUTH_MODE environment variable must be one of "+strings.Join(authModes, ", ")+", got: "+opts.Mode,
+		)
+		return opts, diags
+	}
+
+	// A token from the configuration replaces the one from the environment
+	// in either form.
+	if !config.Token.IsNull() || !config.TokenFile.IsNull() {
+		opts.Token = config.Token.ValueString()
+		tokenFile = config.TokenFile.ValueString()
+	}
+
+	if tokenFile != "" && opts.Token == "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("token_file"),
+				"Unable to Read onos API Token File",
+				"The provider cannot read the onos API token file: "+err.Error(),
+			)
+			return opts, diags
+		}
+		opts.Token = strings.TrimSpace(string(token))
+	}
+
+	if !config.CustomHeaders.IsNull() {
+		diags.Append(config.CustomHeaders.ElementsAs(ctx, &opts.Headers, false)...)
+	}
+
+	return opts, diags
+}
+
+// splitHosts splits a comma separated list of hosts.
+func splitHosts(v string) []string {
+	var hosts []string
+	for _, host := range strings.Split(v, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// transportOptionsFromConfig returns the request timeout, retry and rate limit
+// options of the configuration, defaulting to their environment variables.
+func transportOptionsFromConfig(config onosProviderModel) (transportOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := transportOptions{
+		RequestTimeout:  envDuration(&diags, "request_timeout", defaultRequestTimeout),
+		MaxRetries:      envInt64(&diags, "max_retries", defaultMaxRetries),
+		RetryBackoffMin: envDuration(&diags, "retry_backoff_min", defaultRetryBackoffMin),
+		RetryBackoffMax: envDuration(&diags, "retry_backoff_max", defaultRetryBackoffMax),
+
+		RequestsPerSecond: envInt64(&diags, "max_requests_per_second", 0),
+	}
+
+	// The schema validators already rejected invalid durations.
+	if !config.RequestTimeout.IsNull() {
+		opts.RequestTimeout, _ = time.ParseDuration(config.RequestTimeout.ValueString())
+	}
+
+	if !config.MaxRetries.IsNull() {
+		opts.MaxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	if !config.RetryBackoffMin.IsNull() {
+		opts.RetryBackoffMin, _ = time.ParseDuration(config.RetryBackoffMin.ValueString())
+	}
+
+	if !config.RetryBackoffMax.IsNull() {
+		opts.RetryBackoffMax, _ = time.ParseDuration(config.RetryBackoffMax.ValueString())
+	}
+
+	if !config.MaxRequestsPerSecond.IsNull() {
+		opts.RequestsPerSecond = config.MaxRequestsPerSecond.ValueInt64()
+	}
+
+	if opts.RetryBackoffMin > opts.RetryBackoffMax {
+		diags.AddAttributeError(
+			path.Root("retry_backoff_min"),
+			"Invalid Retry Backoff",
+			fmt.Sprintf("The retry_backoff_min value (%s) must not be greater than the retry_backoff_max value (%s).", opts.RetryBackoffMin, opts.RetryBackoffMax),
+		)
+	}
+
+	return opts, diags
+}
+
+// envDuration returns the positive duration of the ONOS_ environment variable
+// of attribute, or def when it is not set.
+func envDuration(diags *diag.Diagnostics, attribute string, def time.Duration) time.Duration {
+	name := "ONOS_" + strings.ToUpper(attribute)
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+name+" Environment Variable",
+			"The "+name+" environment variable must be a positive duration such as 30s, got: "+v,
+		)
+		return def
+	}
+	return d
+}
+
+// envBool returns the boolean of the ONOS_ environment variable of attribute,
+// or false when it is not set.
+func envBool(diags *diag.Diagnostics, attribute string) bool {
+	name := "ONOS_" + strings.ToUpper(attribute)
+	v := os.Getenv(name)
+	if v == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+name+" Environment Variable",
+			"The "+name+" environment variable must be true or false, got: "+v,
+		)
+		return false
+	}
+	return b
+}
+
+// envInt64 returns the non-negative integer of the ONOS_ environment variable
+// of attribute, or def when it is not set.
+func envInt64(diags *diag.Diagnostics, attribute string, def int64) int64 {
+	name := "ONOS_" + strings.ToUpper(attribute)
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || i < 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+name+" Environment Variable",
+			"The "+name+" environment variable must be a non-negative integer, got: "+v,
+		)
+		return def
+	}
+	return i
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *onosProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewFlowsDataSource,
 		NewHostsDataSource,
+		NewMetersDataSource,
+		NewMastershipDataSource,
+		NewClusterDataSource,
+		NewComponentConfigDataSource,
+		NewRegionsDataSource,
+		NewPortStatisticsDataSource,
+		NewFlowTableStatisticsDataSource,
+		NewLinkStatisticsDataSource,
+		NewMcastRoutesDataSource,
+		NewRoutesDataSource,
+		NewPacketProcessorsDataSource,
+		NewSystemDataSource,
 	}
 }
 
@@ -214,5 +726,22 @@ func (p *onosProvider) DataSources(_ context.Context) []func() datasource.DataSo
 func (p *onosProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIntentResource,
+		NewMeterResource,
+		NewForwardingObjectiveResource,
+		NewNextObjectiveResource,
+		NewDeviceConfigResource,
+		NewDevicePortStateResource,
+		NewDeviceResource,
+		NewMastershipResource,
+		NewComponentConfigResource,
+		NewRegionResource,
+		NewVplsResource,
+		NewSegmentRoutingDeviceResource,
+		NewSegmentRoutingXconnectResource,
+		NewSegmentRoutingSubnetResource,
+		NewMcastRouteResource,
+		NewDhcpRelayResource,
+		NewRouteResource,
+		NewRouteBulkResource,
 	}
 }
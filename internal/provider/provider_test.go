@@ -1,26 +1,34 @@
 package provider
 
 import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-onos/internal/onosfake"
 )
 
-const (
+var (
 	// providerConfig is a shared configuration to combine with the actual
 	// test configuration so the Onos client is properly configured.
-	// It is also possible to use the ONOS_ environment variables instead,
-	// such as updating the Makefile and running the testing through that tool.
-	providerConfig = `
-	provider "onos" {
-		host     = "http://localhost:8181/onos/v1"
-		username = "onos"
-		password = "rocks"
-	  }
-	  
-`
-)
+	// It points at the ONOS_HOST controller, or at an in-memory ONOS stand-in
+	// when ONOS_HOST is unset, see TestMain.
+	providerConfig string
+
+	// testAccFakeONOS is the in-memory ONOS stand-in the acceptance tests run
+	// against, nil when they run against the ONOS_HOST controller.
+	testAccFakeONOS *onosfake.Server
 
-var (
 	// testAccProtoV6ProviderFactories are used to instantiate a provider during
 	// acceptance testing. The factory function will be invoked for every Terraform
 	// CLI command executed to create a provider server to which the CLI can
@@ -29,3 +37,140 @@ var (
 		"onos": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+func TestMain(m *testing.M) {
+	host := os.Getenv("ONOS_HOST")
+	if host == "" {
+		fake, err := onosfake.New(onosfake.Fixtures)
+		if err != nil {
+			log.Fatalf("starting the ONOS stand-in: %s", err)
+		}
+		server := httptest.NewServer(fake)
+		testAccFakeONOS = fake
+		host = server.URL + onosfake.APIPath
+
+		code := runTests(m, host)
+		server.Close()
+		os.Exit(code)
+	}
+
+	os.Exit(runTests(m, host))
+}
+
+func runTests(m *testing.M, host string) int {
+	providerConfig = fmt.Sprintf(`
+	provider "onos" {
+		host     = %q
+		username = "onos"
+		password = "rocks"
+	  }
+
+`, host)
+	return m.Run()
+}
+
+// testAccPreCheck restores the fixtures of the ONOS stand-in, so every test
+// starts from the same objects.
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+
+	if testAccFakeONOS == nil {
+		return
+	}
+	if err := testAccFakeONOS.Reset(); err != nil {
+		t.Fatalf("resetting the ONOS stand-in: %s", err)
+	}
+}
+
+// testAccPreCheckLiveONOS skips tests of the endpoints the ONOS stand-in does
+// not serve unless ONOS_HOST is set.
+func testAccPreCheckLiveONOS(t *testing.T) {
+	t.Helper()
+
+	if testAccFakeONOS != nil {
+		t.Skip("ONOS_HOST must be set, the ONOS stand-in does not serve the endpoints of this test")
+	}
+}
+
+func TestAccProviderFaults(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testAccFakeONOS == nil {
+				t.Skip("ONOS_HOST must be unset, faults are injected into the ONOS stand-in")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Rejected credentials fail the provider configuration
+			{
+				PreConfig: func() {
+					testAccFakeONOS.InjectFault(onosfake.Fault{Status: http.StatusUnauthorized})
+				},
+				Config:      providerConfig + `data "onos_hosts" "test" {}`,
+				ExpectError: regexp.MustCompile(`ONOS Authentication Failed`),
+			},
+			// Transient server errors are retried
+			{
+				PreConfig: func() {
+					testAccFakeONOS.ClearFaults()
+					testAccFakeONOS.InjectFault(onosfake.Fault{Method: "GET", Path: "/hosts", Status: http.StatusServiceUnavailable, Times: 2})
+				},
+				Config: providerConfig + `data "onos_hosts" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onos_hosts.test", "hosts.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderONOSVersion(t *testing.T) {
+	// An ONOS stand-in of a release older than some features require.
+	fake, err := onosfake.New(fstest.MapFS{
+		"system.json": {Data: []byte(`{"node": "172.17.0.2", "version": "2.0.0", "clusterId": "default"}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+	provider "onos" {
+		host     = %q
+		username = "onos"
+		password = "rocks"
+	  }
+
+`, server.URL+onosfake.APIPath)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config + `data "onos_packet_processors" "test" {}`,
+				ExpectError: regexp.MustCompile(`requires ONOS 2\.1\.0 or later`),
+			},
+		},
+	})
+}
+
+// newTestFakeClient starts an ONOS stand-in and returns a client of it, for
+// unit tests of the requests of resources and data sources.
+func newTestFakeClient(t *testing.T) (*onosfake.Server, *onosclient.Client) {
+	t.Helper()
+
+	fake, err := onosfake.New(onosfake.Fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := onosclient.NewClient(server.URL+onosfake.APIPath, onosfake.DefaultUsername, onosfake.DefaultPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fake, client
+}
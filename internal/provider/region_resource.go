@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &regionResource{}
+	_ resource.ResourceWithConfigure   = &regionResource{}
+	_ resource.ResourceWithImportState = &regionResource{}
+)
+
+// regionTypes are the region types known to ONOS.
+var regionTypes = []string{
+	"CONTINENT", "COUNTRY", "METRO", "CAMPUS", "BUILDING",
+	"DATA_CENTER", "FLOOR", "ROOM", "RACK", "LOGICAL_GROUP",
+}
+
+// regionResource is the resource implementation.
+type regionResource struct {
+	client *onosclient.Client
+}
+
+// NewRegionResource is a helper function to simplify the provider implementation.
+func NewRegionResource() resource.Resource {
+	return &regionResource{}
+}
+
+type regionResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Masters     types.List   `tfsdk:"masters"`
+	Devices     types.Set    `tfsdk:"devices"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// onosRegions maps the ONOS regions response.
+type onosRegions struct {
+	Regions []onosRegion `json:"regions"`
+}
+
+// onosRegion maps an ONOS region. Masters holds the sets of nodes in order of
+// preference.
+type onosRegion struct {
+	ID      string     `json:"id"`
+	Name    string     `json:"name"`
+	Type    string     `json:"type"`
+	Masters [][]string `json:"masters"`
+}
+
+// onosRegionDevices maps the device membership of an ONOS region.
+type onosRegionDevices struct {
+	DeviceIDs []string `json:"deviceIds"`
+}
+
+// Metadata returns the resource type name.
+func (r *regionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_region"
+}
+
+// Schema defines the schema for the resource.
+func (r *regionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a region grouping devices for mastership and the ONOS UI.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the region.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the region.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the region, e.g. CAMPUS, METRO or DATA_CENTER.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(regionTypes...),
+				},
+			},
+			"masters": schema.ListAttribute{
+				Description: "Sets of cluster node IDs eligible as masters of the region's devices, in order of preference.",
+				Optional:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+			},
+			"devices": schema.SetAttribute{
+				Description: "IDs of the devices in the region. Leaving it unset is the same as an empty set, so the devices of the region are removed from it.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the region.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *regionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *regionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan regionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, diags := expandRegion(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", "/regions", region, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating region",
+			"Could not create region, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncDevices(ctx, &plan, types.SetNull(types.StringType))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *regionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state regionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var region onosRegion
+	err := onosRequest(ctx, r.client, "GET", regionPath(state.ID.ValueString()), nil, &region)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Region",
+			"Could not read region "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	devices, err := getRegionDevices(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Region",
+			"Could not read devices of region "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenRegion(ctx, region, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// An unset devices attribute stands for an empty region.
+	if len(devices) > 0 || !state.Devices.IsNull() {
+		state.Devices, diags = types.SetValueFrom(ctx, types.StringType, devices)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *regionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state regionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, diags := expandRegion(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "PUT", regionPath(plan.ID.ValueString()), region, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos Region",
+			"Could not update region "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncDevices(ctx, &plan, state.Devices)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *regionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state regionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "DELETE", regionPath(state.ID.ValueString()), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos region",
+			"Could not delete region, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *regionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// syncDevices adds and removes devices so the region holds the planned
+// devices, none when they are not set.
+func (r *regionResource) syncDevices(ctx context.Context, plan *regionResourceModel, previous types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	regionID := plan.ID.ValueString()
+
+	var planned, current []string
+	if !plan.Devices.IsNull() {
+		diags.Append(plan.Devices.ElementsAs(ctx, &planned, false)...)
+	}
+	if !previous.IsNull() && !previous.IsUnknown() {
+		diags.Append(previous.ElementsAs(ctx, &current, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	added, removed := diffStrings(current, planned)
+	if len(removed) > 0 {
+		err := onosRequest(ctx, r.client, "DELETE", regionPath(regionID)+"/devices", onosRegionDevices{DeviceIDs: removed}, nil)
+		if err != nil {
+			diags.AddError(
+				"Error removing region devices",
+				"Could not remove devices from region "+regionID+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	if len(added) > 0 {
+		err := onosRequest(ctx, r.client, "POST", regionPath(regionID)+"/devices", onosRegionDevices{DeviceIDs: added}, nil)
+		if err != nil {
+			diags.AddError(
+				"Error adding region devices",
+				"Could not add devices to region "+regionID+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	return diags
+}
+
+func regionPath(regionID string) string {
+	return "/regions/" + url.PathEscape(regionID)
+}
+
+func getRegionDevices(ctx context.Context, client *onosclient.Client, regionID string) ([]string, error) {
+	var devices onosRegionDevices
+	err := onosRequest(ctx, client, "GET", regionPath(regionID)+"/devices", nil, &devices)
+	return nonNilStrings(devices.DeviceIDs), err
+}
+
+// diffStrings returns the values of target missing from current and the
+// values of current missing from target.
+func diffStrings(current, target []string) (added, removed []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, value := range current {
+		currentSet[value] = true
+	}
+	targetSet := make(map[string]bool, len(target))
+	for _, value := range target {
+		targetSet[value] = true
+		if !currentSet[value] {
+			added = append(added, value)
+		}
+	}
+	for _, value := range current {
+		if !targetSet[value] {
+			removed = append(removed, value)
+		}
+	}
+	return added, removed
+}
+
+func expandRegion(ctx context.Context, plan regionResourceModel) (onosRegion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	region := onosRegion{
+		ID:      plan.ID.ValueString(),
+		Name:    plan.Name.ValueString(),
+		Type:    plan.Type.ValueString(),
+		Masters: [][]string{},
+	}
+	if !plan.Masters.IsNull() && !plan.Masters.IsUnknown() {
+		diags.Append(plan.Masters.ElementsAs(ctx, &region.Masters, false)...)
+	}
+	return region, diags
+}
+
+func flattenRegion(ctx context.Context, region onosRegion, state *regionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.ID = types.StringValue(region.ID)
+	state.Name = types.StringValue(region.Name)
+	state.Type = types.StringValue(region.Type)
+
+	// Keep masters null when unset in the configuration and ONOS reports none
+	if len(region.Masters) == 0 && state.Masters.IsNull() {
+		return diags
+	}
+	state.Masters, diags = types.ListValueFrom(ctx, types.SetType{ElemType: types.StringType}, region.Masters)
+	return diags
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_region" "test" {
+					id      = "campus-1"
+					name    = "Campus 1"
+					type    = "CAMPUS"
+					devices = ["of:0000000000000001"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_region.test", "id", "campus-1"),
+					resource.TestCheckResourceAttr("onos_region.test", "type", "CAMPUS"),
+					resource.TestCheckResourceAttr("onos_region.test", "devices.#", "1"),
+					resource.TestCheckTypeSetElemAttr("onos_region.test", "devices.*", "of:0000000000000001"),
+					resource.TestCheckResourceAttrSet("onos_region.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_region.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_region" "test" {
+					id      = "campus-1"
+					name    = "Campus One"
+					type    = "CAMPUS"
+					devices = ["of:0000000000000002", "of:0000000000000003"]
+				  }
+
+				data "onos_regions" "test" {
+					depends_on = [onos_region.test]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_region.test", "name", "Campus One"),
+					resource.TestCheckResourceAttr("onos_region.test", "devices.#", "2"),
+					resource.TestCheckTypeSetElemAttr("onos_region.test", "devices.*", "of:0000000000000002"),
+					resource.TestCheckTypeSetElemAttr("onos_region.test", "devices.*", "of:0000000000000003"),
+					resource.TestCheckResourceAttr("data.onos_regions.test", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.onos_regions.test", "regions.0.devices.#", "2"),
+				),
+			},
+			// Removing devices empties the region
+			{
+				Config: providerConfig + `
+				resource "onos_region" "test" {
+					id   = "campus-1"
+					name = "Campus One"
+					type = "CAMPUS"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("onos_region.test", "devices.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDiffStrings(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []string
+		target      []string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{name: "same", current: []string{"a", "b"}, target: []string{"b", "a"}},
+		{name: "added", current: []string{"a"}, target: []string{"a", "b", "c"}, wantAdded: []string{"b", "c"}},
+		{name: "removed", current: []string{"a", "b", "c"}, target: []string{"b"}, wantRemoved: []string{"a", "c"}},
+		{name: "replaced", current: []string{"a", "b"}, target: []string{"b", "c"}, wantAdded: []string{"c"}, wantRemoved: []string{"a"}},
+		{name: "from empty", target: []string{"a"}, wantAdded: []string{"a"}},
+		{name: "to empty", current: []string{"a"}, wantRemoved: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := diffStrings(tt.current, tt.target)
+			if !slices.Equal(added, tt.wantAdded) || !slices.Equal(removed, tt.wantRemoved) {
+				t.Errorf("expected added %v and removed %v, got %v and %v", tt.wantAdded, tt.wantRemoved, added, removed)
+			}
+		})
+	}
+}
+
+func TestFlattenRegion(t *testing.T) {
+	ctx := context.Background()
+	mastersType := types.SetType{ElemType: types.StringType}
+
+	tests := []struct {
+		name        string
+		region      onosRegion
+		masters     types.List
+		wantMasters [][]string
+	}{
+		{
+			name:        "masters",
+			region:      onosRegion{ID: "r1", Name: "Region 1", Type: "CAMPUS", Masters: [][]string{{"node1", "node2"}, {"node3"}}},
+			masters:     types.ListNull(mastersType),
+			wantMasters: [][]string{{"node1", "node2"}, {"node3"}},
+		},
+		{
+			name:    "no masters configured",
+			region:  onosRegion{ID: "r1", Name: "Region 1", Type: "CAMPUS", Masters: [][]string{}},
+			masters: types.ListNull(mastersType),
+		},
+		{
+			// Masters configured but removed outside of Terraform show as drift.
+			name:        "masters removed",
+			region:      onosRegion{ID: "r1", Name: "Region 1", Type: "CAMPUS", Masters: [][]string{}},
+			masters:     types.ListValueMust(mastersType, []attr.Value{types.SetValueMust(types.StringType, []attr.Value{types.StringValue("node1")})}),
+			wantMasters: [][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := regionResourceModel{Masters: tt.masters}
+			if diags := flattenRegion(ctx, tt.region, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if state.ID.ValueString() != "r1" || state.Name.ValueString() != "Region 1" || state.Type.ValueString() != "CAMPUS" {
+				t.Errorf("expected the region fields to be copied, got %+v", state)
+			}
+			if tt.wantMasters == nil {
+				if !state.Masters.IsNull() {
+					t.Errorf("expected null masters, got %s", state.Masters)
+				}
+				return
+			}
+
+			// The masters sent back to ONOS match the ones read.
+			region, diags := expandRegion(ctx, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(region.Masters, tt.wantMasters) {
+				t.Errorf("expected masters %v, got %v", tt.wantMasters, region.Masters)
+			}
+		})
+	}
+}
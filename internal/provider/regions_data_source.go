@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &regionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &regionsDataSource{}
+)
+
+// NewRegionsDataSource is a helper function to simplify the provider implementation.
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{}
+}
+
+// regionsDataSource is the data source implementation.
+type regionsDataSource struct {
+	client *onosclient.Client
+}
+
+type regionsDataSourceModel struct {
+	Regions []regionModel `tfsdk:"regions"`
+}
+
+type regionModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Masters types.List   `tfsdk:"masters"`
+	Devices types.Set    `tfsdk:"devices"`
+}
+
+// Metadata returns the data source type name.
+func (d *regionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+// Schema defines the schema for the data source.
+func (d *regionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the regions and their devices.",
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				Description: "Regions known to ONOS.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the region.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the region.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the region, e.g. CAMPUS, METRO or DATA_CENTER.",
+							Computed:    true,
+						},
+						"masters": schema.ListAttribute{
+							Description: "Sets of cluster node IDs eligible as masters of the region's devices, in order of preference.",
+							Computed:    true,
+							ElementType: types.SetType{ElemType: types.StringType},
+						},
+						"devices": schema.SetAttribute{
+							Description: "IDs of the devices in the region.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *regionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *regionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state regionsDataSourceModel
+
+	var regions onosRegions
+	err := onosRequest(ctx, d.client, "GET", "/regions", nil, &regions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Regions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Regions = []regionModel{}
+	for _, region := range regions.Regions {
+		devices, err := getRegionDevices(ctx, d.client, region.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Onos Region Devices",
+				"Could not read devices of region "+region.ID+": "+err.Error(),
+			)
+			return
+		}
+
+		masters := region.Masters
+		if masters == nil {
+			masters = [][]string{}
+		}
+		mastersValue, diags := types.ListValueFrom(ctx, types.SetType{ElemType: types.StringType}, masters)
+		resp.Diagnostics.Append(diags...)
+		devicesValue, diags := types.SetValueFrom(ctx, types.StringType, devices)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Regions = append(state.Regions, regionModel{
+			ID:      types.StringValue(region.ID),
+			Name:    types.StringValue(region.Name),
+			Type:    types.StringValue(region.Type),
+			Masters: mastersValue,
+			Devices: devicesValue,
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &routeBulkResource{}
+	_ resource.ResourceWithConfigure = &routeBulkResource{}
+)
+
+// routeBulkResource is the resource implementation.
+type routeBulkResource struct {
+	client *onosclient.Client
+}
+
+// NewRouteBulkResource is a helper function to simplify the provider implementation.
+func NewRouteBulkResource() resource.Resource {
+	return &routeBulkResource{}
+}
+
+type routeBulkResourceModel struct {
+	ID          types.String     `tfsdk:"id"`
+	Routes      []bulkRouteModel `tfsdk:"routes"`
+	LastUpdated types.String     `tfsdk:"last_updated"`
+}
+
+type bulkRouteModel struct {
+	Prefix  types.String `tfsdk:"prefix"`
+	NextHop types.String `tfsdk:"next_hop"`
+	Source  types.String `tfsdk:"source"`
+}
+
+// Metadata returns the resource type name.
+func (r *routeBulkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route_bulk"
+}
+
+// Schema defines the schema for the resource.
+func (r *routeBulkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of unicast routes of the ONOS route service, added and removed with bulk requests.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the route set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				Description: "Routes to install.",
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Description: "Destination prefix of the route, e.g. 10.0.1.0/24.",
+							Required:    true,
+							Validators: []validator.String{
+								prefixValidator{},
+							},
+						},
+						"next_hop": schema.StringAttribute{
+							Description: "IP address of the next hop.",
+							Required:    true,
+							Validators: []validator.String{
+								ipAddressValidator{},
+							},
+						},
+						"source": schema.StringAttribute{
+							Description: "Source of the route, e.g. STATIC or FPM. STATIC when not set.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(routeSources...),
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the routes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *routeBulkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *routeBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan routeBulkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", "/routes/bulk", onosBulkRoutes{Routes: expandBulkRoutes(plan.Routes)}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating routes",
+			"Could not create routes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(time.Now().UnixNano(), 10))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *routeBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state routeBulkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := getRoutes(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Routes",
+			"Could not read routes: "+err.Error(),
+		)
+		return
+	}
+
+	// Drop the routes removed outside of Terraform so they are planned again
+	present := []bulkRouteModel{}
+	for _, route := range state.Routes {
+		if _, found := findRoute(routes, route.Prefix.ValueString(), route.NextHop.ValueString()); found {
+			present = append(present, route)
+		}
+	}
+	if len(present) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Routes = present
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update removes the routes no longer planned and adds the new ones.
+func (r *routeBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state routeBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := diffRoutes(expandBulkRoutes(state.Routes), expandBulkRoutes(plan.Routes))
+	if len(removed) > 0 {
+		err := onosRequest(ctx, r.client, "DELETE", "/routes/bulk", onosBulkRoutes{Routes: removed}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Onos Routes",
+				"Could not remove routes, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	if len(added) > 0 {
+		err := onosRequest(ctx, r.client, "POST", "/routes/bulk", onosBulkRoutes{Routes: added}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Onos Routes",
+				"Could not add routes, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *routeBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state routeBulkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "DELETE", "/routes/bulk", onosBulkRoutes{Routes: expandBulkRoutes(state.Routes)}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos routes",
+			"Could not delete routes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func expandBulkRoutes(models []bulkRouteModel) []onosRoute {
+	routes := []onosRoute{}
+	for _, m := range models {
+		route := onosRoute{
+			Prefix:  m.Prefix.ValueString(),
+			NextHop: m.NextHop.ValueString(),
+			Source:  m.Source.ValueString(),
+		}
+		if route.Source == "" {
+			route.Source = "STATIC"
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// diffRoutes returns the routes of target missing from current and the
+// routes of current missing from target.
+func diffRoutes(current, target []onosRoute) (added, removed []onosRoute) {
+	currentSet := make(map[onosRoute]bool, len(current))
+	for _, route := range current {
+		currentSet[route] = true
+	}
+	targetSet := make(map[onosRoute]bool, len(target))
+	for _, route := range target {
+		targetSet[route] = true
+		if !currentSet[route] {
+			added = append(added, route)
+		}
+	}
+	for _, route := range current {
+		if !targetSet[route] {
+			removed = append(removed, route)
+		}
+	}
+	return added, removed
+}
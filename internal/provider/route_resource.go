@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &routeResource{}
+	_ resource.ResourceWithConfigure   = &routeResource{}
+	_ resource.ResourceWithImportState = &routeResource{}
+)
+
+// routeSources are the route sources known to the ONOS route service.
+var routeSources = []string{"STATIC", "BGP", "OSPF", "FPM", "RIP", "DHCP", "DHCPLQ", "UI", "UNDEFINED"}
+
+// routeResource is the resource implementation.
+type routeResource struct {
+	client *onosclient.Client
+}
+
+// NewRouteResource is a helper function to simplify the provider implementation.
+func NewRouteResource() resource.Resource {
+	return &routeResource{}
+}
+
+type routeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Prefix      types.String `tfsdk:"prefix"`
+	NextHop     types.String `tfsdk:"next_hop"`
+	Source      types.String `tfsdk:"source"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// onosRoutes maps the ONOS route service response, with one list per route
+// table.
+type onosRoutes struct {
+	Routes4 []onosRoute `json:"routes4"`
+	Routes6 []onosRoute `json:"routes6"`
+}
+
+// onosRoute maps a unicast route of the ONOS route service.
+type onosRoute struct {
+	Prefix  string `json:"prefix"`
+	NextHop string `json:"nextHop"`
+	Source  string `json:"source,omitempty"`
+}
+
+// onosBulkRoutes maps the ONOS bulk route request.
+type onosBulkRoutes struct {
+	Routes []onosRoute `json:"routes"`
+}
+
+// Metadata returns the resource type name.
+func (r *routeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route"
+}
+
+// Schema defines the schema for the resource.
+func (r *routeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a unicast route of the ONOS route service. Use onos_route_bulk to create many routes in one request.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the route in the form prefix,next_hop.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Destination prefix of the route, e.g. 10.0.1.0/24.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					prefixValidator{},
+				},
+			},
+			"next_hop": schema.StringAttribute{
+				Description: "IP address of the next hop.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Source of the route, e.g. STATIC or FPM. Defaults to STATIC.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("STATIC"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(routeSources...),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the route.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *routeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan routeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", "/routes", expandRoute(plan), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating route",
+			"Could not create route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Prefix.ValueString() + "," + plan.NextHop.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state routeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := getRoutes(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Route",
+			"Could not read route "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	route, found := findRoute(routes, state.Prefix.ValueString(), state.NextHop.ValueString())
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Source = types.StringValue(route.Source)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only saves the plan, as every attribute sent to ONOS requires
+// replacement.
+func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan routeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *routeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state routeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "DELETE", "/routes", expandRoute(state), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos route",
+			"Could not delete route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *routeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Pass in the prefix and next hop, e.g. terraform import onos_route.default "0.0.0.0/0,10.0.1.254"
+	prefix, nextHop, ok := strings.Cut(req.ID, ",")
+	if !ok || prefix == "" || nextHop == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Prefix,NextHop. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prefix"), prefix)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("next_hop"), nextHop)...)
+}
+
+func expandRoute(m routeResourceModel) onosRoute {
+	return onosRoute{
+		Prefix:  m.Prefix.ValueString(),
+		NextHop: m.NextHop.ValueString(),
+		Source:  m.Source.ValueString(),
+	}
+}
+
+// getRoutes returns the routes of all route tables.
+func getRoutes(ctx context.Context, client *onosclient.Client) (onosRoutes, error) {
+	var routes onosRoutes
+	err := onosRequest(ctx, client, "GET", "/routes", nil, &routes)
+	return routes, err
+}
+
+// findRoute looks up a route by prefix and next hop in all route tables.
+func findRoute(routes onosRoutes, prefix, nextHop string) (onosRoute, bool) {
+	for _, table := range [][]onosRoute{routes.Routes4, routes.Routes6} {
+		for _, route := range table {
+			if route.Prefix == prefix && route.NextHop == nextHop {
+				return route, true
+			}
+		}
+	}
+	return onosRoute{}, false
+}
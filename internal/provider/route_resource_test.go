@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRouteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_route" "test" {
+					prefix   = "10.0.10.0/24"
+					next_hop = "10.0.0.1"
+				  }
+
+				data "onos_routes" "test" {
+					depends_on = [onos_route.test]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_route.test", "id", "10.0.10.0/24,10.0.0.1"),
+					resource.TestCheckResourceAttr("onos_route.test", "source", "STATIC"),
+					resource.TestCheckResourceAttrSet("onos_route.test", "last_updated"),
+					resource.TestCheckResourceAttr("data.onos_routes.test", "tables.#", "2"),
+					resource.TestCheckResourceAttr("data.onos_routes.test", "tables.0.name", "ipv4"),
+					resource.TestCheckResourceAttr("data.onos_routes.test", "tables.0.routes.0.prefix", "10.0.10.0/24"),
+					// 10.0.0.1 is h1 of the mininet topology
+					resource.TestCheckResourceAttr("data.onos_routes.test", "tables.0.routes.0.next_hop_mac", "00:00:00:00:00:01"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_route.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRouteBulkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_route_bulk" "test" {
+					routes = [
+						{ prefix = "10.0.20.0/24", next_hop = "10.0.0.1" },
+						{ prefix = "10.0.21.0/24", next_hop = "10.0.0.1" },
+					]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_route_bulk.test", "routes.#", "2"),
+					resource.TestCheckResourceAttrSet("onos_route_bulk.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_route_bulk" "test" {
+					routes = [
+						{ prefix = "10.0.20.0/24", next_hop = "10.0.0.1" },
+						{ prefix = "10.0.22.0/24", next_hop = "10.0.0.2", source = "UI" },
+					]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_route_bulk.test", "routes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("onos_route_bulk.test", "routes.*", map[string]string{
+						"prefix": "10.0.22.0/24",
+						"source": "UI",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestExpandBulkRoutes(t *testing.T) {
+	models := []bulkRouteModel{
+		{Prefix: types.StringValue("10.1.0.0/16"), NextHop: types.StringValue("10.0.0.1"), Source: types.StringNull()},
+		{Prefix: types.StringValue("2001:db8::/32"), NextHop: types.StringValue("2001:db8::1"), Source: types.StringValue("FPM")},
+	}
+	want := []onosRoute{
+		{Prefix: "10.1.0.0/16", NextHop: "10.0.0.1", Source: "STATIC"},
+		{Prefix: "2001:db8::/32", NextHop: "2001:db8::1", Source: "FPM"},
+	}
+	if got := expandBulkRoutes(models); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+	if got := expandBulkRoutes(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty route list, got %+v", got)
+	}
+}
+
+func TestDiffRoutes(t *testing.T) {
+	route1 := onosRoute{Prefix: "10.1.0.0/16", NextHop: "10.0.0.1", Source: "STATIC"}
+	route2 := onosRoute{Prefix: "10.2.0.0/16", NextHop: "10.0.0.1", Source: "STATIC"}
+	route2NextHop := onosRoute{Prefix: "10.2.0.0/16", NextHop: "10.0.0.2", Source: "STATIC"}
+	route2Source := onosRoute{Prefix: "10.2.0.0/16", NextHop: "10.0.0.1", Source: "FPM"}
+
+	tests := []struct {
+		name        string
+		current     []onosRoute
+		target      []onosRoute
+		wantAdded   []onosRoute
+		wantRemoved []onosRoute
+	}{
+		{name: "same", current: []onosRoute{route1, route2}, target: []onosRoute{route2, route1}},
+		{name: "added", current: []onosRoute{route1}, target: []onosRoute{route1, route2}, wantAdded: []onosRoute{route2}},
+		{name: "removed", current: []onosRoute{route1, route2}, target: []onosRoute{route1}, wantRemoved: []onosRoute{route2}},
+		{name: "next hop changed", current: []onosRoute{route1, route2}, target: []onosRoute{route1, route2NextHop}, wantAdded: []onosRoute{route2NextHop}, wantRemoved: []onosRoute{route2}},
+		{name: "source changed", current: []onosRoute{route2}, target: []onosRoute{route2Source}, wantAdded: []onosRoute{route2Source}, wantRemoved: []onosRoute{route2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := diffRoutes(tt.current, tt.target)
+			if !slices.Equal(added, tt.wantAdded) || !slices.Equal(removed, tt.wantRemoved) {
+				t.Errorf("expected added %+v and removed %+v, got %+v and %+v", tt.wantAdded, tt.wantRemoved, added, removed)
+			}
+		})
+	}
+}
+
+func TestFindRoute(t *testing.T) {
+	routes := onosRoutes{
+		Routes4: []onosRoute{{Prefix: "10.1.0.0/16", NextHop: "10.0.0.1", Source: "STATIC"}},
+		Routes6: []onosRoute{{Prefix: "2001:db8::/32", NextHop: "2001:db8::1", Source: "FPM"}},
+	}
+
+	tests := []struct {
+		name      string
+		prefix    string
+		nextHop   string
+		want      onosRoute
+		wantFound bool
+	}{
+		{name: "ipv4", prefix: "10.1.0.0/16", nextHop: "10.0.0.1", want: routes.Routes4[0], wantFound: true},
+		{name: "ipv6", prefix: "2001:db8::/32", nextHop: "2001:db8::1", want: routes.Routes6[0], wantFound: true},
+		{name: "other next hop", prefix: "10.1.0.0/16", nextHop: "10.0.0.2"},
+		{name: "missing", prefix: "10.9.0.0/16", nextHop: "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := findRoute(routes, tt.prefix, tt.nextHop)
+			if found != tt.wantFound || got != tt.want {
+				t.Errorf("expected %+v (found %t), got %+v (found %t)", tt.want, tt.wantFound, got, found)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &routesDataSource{}
+	_ datasource.DataSourceWithConfigure = &routesDataSource{}
+)
+
+// NewRoutesDataSource is a helper function to simplify the provider implementation.
+func NewRoutesDataSource() datasource.DataSource {
+	return &routesDataSource{}
+}
+
+// routesDataSource is the data source implementation.
+type routesDataSource struct {
+	client *onosclient.Client
+}
+
+type routesDataSourceModel struct {
+	Tables []routeTableModel `tfsdk:"tables"`
+}
+
+type routeTableModel struct {
+	Name   types.String         `tfsdk:"name"`
+	Routes []resolvedRouteModel `tfsdk:"routes"`
+}
+
+type resolvedRouteModel struct {
+	Prefix           types.String          `tfsdk:"prefix"`
+	NextHop          types.String          `tfsdk:"next_hop"`
+	Source           types.String          `tfsdk:"source"`
+	NextHopMac       types.String          `tfsdk:"next_hop_mac"`
+	NextHopLocations []hostsLocationsModel `tfsdk:"next_hop_locations"`
+}
+
+// Metadata returns the data source type name.
+func (d *routesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routes"
+}
+
+// Schema defines the schema for the data source.
+func (d *routesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the unicast routes of the route service per route table, with the next hops resolved to hosts.",
+		Attributes: map[string]schema.Attribute{
+			"tables": schema.ListNestedAttribute{
+				Description: "Route tables.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the route table, ipv4 or ipv6.",
+							Computed:    true,
+						},
+						"routes": schema.ListNestedAttribute{
+							Description: "Routes of the table.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"prefix": schema.StringAttribute{
+										Description: "Destination prefix of the route.",
+										Computed:    true,
+									},
+									"next_hop": schema.StringAttribute{
+										Description: "IP address of the next hop.",
+										Computed:    true,
+									},
+									"source": schema.StringAttribute{
+										Description: "Source of the route.",
+										Computed:    true,
+									},
+									"next_hop_mac": schema.StringAttribute{
+										Description: "MAC address of the next hop host, null while the next hop is unresolved.",
+										Computed:    true,
+									},
+									"next_hop_locations": schema.ListNestedAttribute{
+										Description: "Locations of the next hop host.",
+										Computed:    true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"elementid": schema.StringAttribute{
+													Description: "ID of the device.",
+													Computed:    true,
+												},
+												"port": schema.StringAttribute{
+													Description: "Port number on the device.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *routesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *routesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state routesDataSourceModel
+
+	routes, err := getRoutes(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Routes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Next hops are resolved the same way ONOS does, through the hosts
+	// learned with the next hop IP address.
+	hosts, err := d.client.GetHosts()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos Hosts",
+			err.Error(),
+		)
+		return
+	}
+	hostsByIP := map[string]onosclient.Host{}
+	for _, host := range hosts.Hosts {
+		for _, ip := range host.IPAddresses {
+			hostsByIP[ip] = host
+		}
+	}
+
+	state.Tables = []routeTableModel{}
+	for _, table := range []struct {
+		name   string
+		routes []onosRoute
+	}{
+		{"ipv4", routes.Routes4},
+		{"ipv6", routes.Routes6},
+	} {
+		tableState := routeTableModel{
+			Name:   types.StringValue(table.name),
+			Routes: []resolvedRouteModel{},
+		}
+		for _, route := range table.routes {
+			routeState := resolvedRouteModel{
+				Prefix:           types.StringValue(route.Prefix),
+				NextHop:          types.StringValue(route.NextHop),
+				Source:           optionalString(route.Source),
+				NextHopMac:       types.StringNull(),
+				NextHopLocations: []hostsLocationsModel{},
+			}
+			if host, ok := hostsByIP[route.NextHop]; ok {
+				routeState.NextHopMac = types.StringValue(host.Mac)
+				for _, location := range host.Locations {
+					routeState.NextHopLocations = append(routeState.NextHopLocations, hostsLocationsModel{
+						ElementID: types.StringValue(location.ElementID),
+						Port:      types.StringValue(location.Port),
+					})
+				}
+			}
+			tableState.Routes = append(tableState.Routes, routeState)
+		}
+		state.Tables = append(state.Tables, tableState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &segmentRoutingDeviceResource{}
+	_ resource.ResourceWithConfigure   = &segmentRoutingDeviceResource{}
+	_ resource.ResourceWithImportState = &segmentRoutingDeviceResource{}
+)
+
+// Segment IDs are MPLS labels; labels 0 to 15 are reserved.
+const (
+	minSegmentID = 16
+	maxSegmentID = 1048575
+)
+
+// segmentRoutingDeviceResource is the resource implementation.
+type segmentRoutingDeviceResource struct {
+	client *onosclient.Client
+}
+
+// NewSegmentRoutingDeviceResource is a helper function to simplify the provider implementation.
+func NewSegmentRoutingDeviceResource() resource.Resource {
+	return &segmentRoutingDeviceResource{}
+}
+
+type segmentRoutingDeviceResourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	DeviceID      types.String        `tfsdk:"device_id"`
+	Name          types.String        `tfsdk:"name"`
+	IPv4NodeSID   types.Int64         `tfsdk:"ipv4_node_sid"`
+	IPv4Loopback  types.String        `tfsdk:"ipv4_loopback"`
+	IPv6NodeSID   types.Int64         `tfsdk:"ipv6_node_sid"`
+	IPv6Loopback  types.String        `tfsdk:"ipv6_loopback"`
+	RouterMAC     types.String        `tfsdk:"router_mac"`
+	IsEdgeRouter  types.Bool          `tfsdk:"is_edge_router"`
+	AdjacencySIDs []adjacencySIDModel `tfsdk:"adjacency_sids"`
+	LastUpdated   types.String        `tfsdk:"last_updated"`
+}
+
+type adjacencySIDModel struct {
+	AdjSID types.Int64 `tfsdk:"adj_sid"`
+	Ports  types.List  `tfsdk:"ports"`
+}
+
+// onosSegmentRoutingConfig maps the segmentrouting netcfg of a device.
+type onosSegmentRoutingConfig struct {
+	Name             string             `json:"name,omitempty"`
+	IPv4NodeSID      int64              `json:"ipv4NodeSid"`
+	IPv4Loopback     string             `json:"ipv4Loopback"`
+	IPv6NodeSID      *int64             `json:"ipv6NodeSid,omitempty"`
+	IPv6Loopback     string             `json:"ipv6Loopback,omitempty"`
+	RouterMACAddress string             `json:"routerMacAddress"`
+	IsEdgeRouter     bool               `json:"isEdgeRouter"`
+	AdjacencySIDs    []onosAdjacencySID `json:"adjacencySids"`
+}
+
+// onosAdjacencySID maps an adjacency segment ID and the ports it covers.
+type onosAdjacencySID struct {
+	AdjSID int64   `json:"adjSid"`
+	Ports  []int64 `json:"ports"`
+}
+
+// Metadata returns the resource type name.
+func (r *segmentRoutingDeviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment_routing_device"
+}
+
+// Schema defines the schema for the resource.
+func (r *segmentRoutingDeviceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the segment routing network configuration of a fabric device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the device.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device to configure.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the router.",
+				Optional:    true,
+			},
+			"ipv4_node_sid": schema.Int64Attribute{
+				Description: fmt.Sprintf("IPv4 node segment ID, between %d and %d.", minSegmentID, maxSegmentID),
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(minSegmentID, maxSegmentID),
+				},
+			},
+			"ipv4_loopback": schema.StringAttribute{
+				Description: "IPv4 loopback address of the router.",
+				Required:    true,
+				Validators: []validator.String{
+					ipAddressValidator{version: 4},
+				},
+			},
+			"ipv6_node_sid": schema.Int64Attribute{
+				Description: fmt.Sprintf("IPv6 node segment ID, between %d and %d.", minSegmentID, maxSegmentID),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(minSegmentID, maxSegmentID),
+					int64validator.AlsoRequires(path.MatchRoot("ipv6_loopback")),
+				},
+			},
+			"ipv6_loopback": schema.StringAttribute{
+				Description: "IPv6 loopback address of the router.",
+				Optional:    true,
+				Validators: []validator.String{
+					ipAddressValidator{version: 6},
+					stringvalidator.AlsoRequires(path.MatchRoot("ipv6_node_sid")),
+				},
+			},
+			"router_mac": schema.StringAttribute{
+				Description: "MAC address of the router, e.g. 00:00:00:00:01:80.",
+				Required:    true,
+				Validators: []validator.String{
+					macAddressValidator,
+				},
+			},
+			"is_edge_router": schema.BoolAttribute{
+				Description: "Whether the device is a leaf connecting hosts.",
+				Required:    true,
+			},
+			"adjacency_sids": schema.ListNestedAttribute{
+				Description: "Adjacency segment IDs of the router.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"adj_sid": schema.Int64Attribute{
+							Description: fmt.Sprintf("Adjacency segment ID, between %d and %d.", minSegmentID, maxSegmentID),
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(minSegmentID, maxSegmentID),
+							},
+						},
+						"ports": schema.ListAttribute{
+							Description: "Port numbers the adjacency segment ID applies to.",
+							Required:    true,
+							ElementType: types.Int64Type,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the segment routing config.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *segmentRoutingDeviceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *segmentRoutingDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan segmentRoutingDeviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := expandSegmentRoutingConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", netcfgPath("devices", plan.DeviceID.ValueString(), "segmentrouting"), config, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error applying segment routing config",
+			"Could not apply segment routing config of device "+plan.DeviceID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.DeviceID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *segmentRoutingDeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state segmentRoutingDeviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config onosSegmentRoutingConfig
+	err := onosRequest(ctx, r.client, "GET", netcfgPath("devices", state.DeviceID.ValueString(), "segmentrouting"), nil, &config)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Segment Routing Config",
+			"Could not read segment routing config of device "+state.DeviceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenSegmentRoutingConfig(ctx, config, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *segmentRoutingDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan segmentRoutingDeviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := expandSegmentRoutingConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", netcfgPath("devices", plan.DeviceID.ValueString(), "segmentrouting"), config, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos Segment Routing Config",
+			"Could not apply segment routing config of device "+plan.DeviceID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.DeviceID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *segmentRoutingDeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state segmentRoutingDeviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "DELETE", netcfgPath("devices", state.DeviceID.ValueString(), "segmentrouting"), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos segment routing config",
+			"Could not delete segment routing config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *segmentRoutingDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID)...)
+}
+
+// expandSegmentRoutingConfig builds the segmentrouting netcfg JSON from the model.
+func expandSegmentRoutingConfig(ctx context.Context, m segmentRoutingDeviceResourceModel) (onosSegmentRoutingConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := onosSegmentRoutingConfig{
+		Name:             m.Name.ValueString(),
+		IPv4NodeSID:      m.IPv4NodeSID.ValueInt64(),
+		IPv4Loopback:     m.IPv4Loopback.ValueString(),
+		IPv6NodeSID:      m.IPv6NodeSID.ValueInt64Pointer(),
+		IPv6Loopback:     m.IPv6Loopback.ValueString(),
+		RouterMACAddress: m.RouterMAC.ValueString(),
+		IsEdgeRouter:     m.IsEdgeRouter.ValueBool(),
+		AdjacencySIDs:    []onosAdjacencySID{},
+	}
+	for _, adjacency := range m.AdjacencySIDs {
+		adjacencySID := onosAdjacencySID{AdjSID: adjacency.AdjSID.ValueInt64()}
+		diags.Append(adjacency.Ports.ElementsAs(ctx, &adjacencySID.Ports, false)...)
+		config.AdjacencySIDs = append(config.AdjacencySIDs, adjacencySID)
+	}
+	return config, diags
+}
+
+// flattenSegmentRoutingConfig copies the segmentrouting netcfg into the model.
+func flattenSegmentRoutingConfig(ctx context.Context, config onosSegmentRoutingConfig, m *segmentRoutingDeviceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Name = optionalString(config.Name)
+	m.IPv4NodeSID = types.Int64Value(config.IPv4NodeSID)
+	m.IPv4Loopback = types.StringValue(config.IPv4Loopback)
+	m.IPv6NodeSID = types.Int64PointerValue(config.IPv6NodeSID)
+	m.IPv6Loopback = optionalString(config.IPv6Loopback)
+	m.RouterMAC = types.StringValue(config.RouterMACAddress)
+	m.IsEdgeRouter = types.BoolValue(config.IsEdgeRouter)
+
+	// Keep adjacency_sids null when unset in the configuration and ONOS reports none
+	if len(config.AdjacencySIDs) == 0 && m.AdjacencySIDs == nil {
+		return diags
+	}
+	m.AdjacencySIDs = []adjacencySIDModel{}
+	for _, adjacency := range config.AdjacencySIDs {
+		ports, d := types.ListValueFrom(ctx, types.Int64Type, adjacency.Ports)
+		diags.Append(d...)
+		m.AdjacencySIDs = append(m.AdjacencySIDs, adjacencySIDModel{
+			AdjSID: types.Int64Value(adjacency.AdjSID),
+			Ports:  ports,
+		})
+	}
+	return diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSegmentRoutingDeviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_device" "test" {
+					device_id      = "of:0000000000000001"
+					ipv4_node_sid  = 10
+					ipv4_loopback  = "192.168.0.1"
+					router_mac     = "00:00:00:00:01:80"
+					is_edge_router = true
+				  }
+`,
+				ExpectError: regexp.MustCompile(`must be between 16 and 1048575`),
+			},
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_device" "test" {
+					device_id      = "of:0000000000000001"
+					ipv4_node_sid  = 101
+					ipv4_loopback  = "192.168.0.1"
+					router_mac     = "00:00:00:00:01"
+					is_edge_router = true
+				  }
+`,
+				ExpectError: regexp.MustCompile(`must be a MAC address`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_device" "test" {
+					device_id      = "of:0000000000000001"
+					name           = "leaf1"
+					ipv4_node_sid  = 101
+					ipv4_loopback  = "192.168.0.1"
+					router_mac     = "00:00:00:00:01:80"
+					is_edge_router = true
+					adjacency_sids = [
+						{
+							adj_sid = 100001
+							ports   = [3, 4]
+						},
+					]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_segment_routing_device.test", "id", "of:0000000000000001"),
+					resource.TestCheckResourceAttr("onos_segment_routing_device.test", "ipv4_node_sid", "101"),
+					resource.TestCheckResourceAttr("onos_segment_routing_device.test", "adjacency_sids.0.ports.#", "2"),
+					resource.TestCheckResourceAttrSet("onos_segment_routing_device.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_segment_routing_device.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_device" "test" {
+					device_id      = "of:0000000000000001"
+					name           = "leaf1"
+					ipv4_node_sid  = 101
+					ipv4_loopback  = "192.168.0.1"
+					router_mac     = "00:00:00:00:01:80"
+					is_edge_router = false
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_segment_routing_device.test", "is_edge_router", "false"),
+					resource.TestCheckNoResourceAttr("onos_segment_routing_device.test", "adjacency_sids"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
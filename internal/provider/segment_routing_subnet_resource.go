@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &segmentRoutingSubnetResource{}
+	_ resource.ResourceWithConfigure   = &segmentRoutingSubnetResource{}
+	_ resource.ResourceWithImportState = &segmentRoutingSubnetResource{}
+)
+
+// segmentRoutingSubnetResource is the resource implementation.
+type segmentRoutingSubnetResource struct {
+	client *onosclient.Client
+}
+
+// NewSegmentRoutingSubnetResource is a helper function to simplify the provider implementation.
+func NewSegmentRoutingSubnetResource() resource.Resource {
+	return &segmentRoutingSubnetResource{}
+}
+
+type segmentRoutingSubnetResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ConnectPoint types.String `tfsdk:"connect_point"`
+	Name         types.String `tfsdk:"name"`
+	Subnets      types.Set    `tfsdk:"subnets"`
+	VlanUntagged types.Int64  `tfsdk:"vlan_untagged"`
+	VlanTagged   types.Set    `tfsdk:"vlan_tagged"`
+	VlanNative   types.Int64  `tfsdk:"vlan_native"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+// onosInterface maps an interface of the interfaces netcfg of a port.
+type onosInterface struct {
+	Name         string   `json:"name,omitempty"`
+	IPs          []string `json:"ips"`
+	VlanUntagged *int64   `json:"vlanUntagged,omitempty"`
+	VlanTagged   []int64  `json:"vlanTagged,omitempty"`
+	VlanNative   *int64   `json:"vlanNative,omitempty"`
+}
+
+// onosPortConfig maps the netcfg of a port.
+type onosPortConfig struct {
+	Interfaces []onosInterface `json:"interfaces"`
+}
+
+// Metadata returns the resource type name.
+func (r *segmentRoutingSubnetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment_routing_subnet"
+}
+
+// Schema defines the schema for the resource.
+func (r *segmentRoutingSubnetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the subnets of a fabric port, as the interface network configuration of the port. The resource owns every interface of the port.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Connect point of the port.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connect_point": schema.StringAttribute{
+				Description: "Connect point of the port, e.g. of:0000000000000001/3.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					connectPointValidator,
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the interface.",
+				Optional:    true,
+			},
+			"subnets": schema.SetAttribute{
+				Description: "Subnets of the port, given as the gateway address of the fabric with the prefix length, e.g. 10.0.1.254/24.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(prefixValidator{interfaceAddress: true}),
+				},
+			},
+			"vlan_untagged": schema.Int64Attribute{
+				Description: "VLAN ID of the untagged traffic of the port.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+					int64validator.ConflictsWith(path.MatchRoot("vlan_tagged")),
+				},
+			},
+			"vlan_tagged": schema.SetAttribute{
+				Description: "VLAN IDs of the tagged traffic of the port.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.Between(1, 4094)),
+				},
+			},
+			"vlan_native": schema.Int64Attribute{
+				Description: "VLAN ID given to the untagged traffic of a port with tagged VLANs.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+					int64validator.AlsoRequires(path.MatchRoot("vlan_tagged")),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the subnets.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *segmentRoutingSubnetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *segmentRoutingSubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan segmentRoutingSubnetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := expandPortConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", netcfgSubjectPath("ports", plan.ConnectPoint.ValueString()), config, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error applying port subnets",
+			"Could not apply the interfaces config of port "+plan.ConnectPoint.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.ConnectPoint
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *segmentRoutingSubnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state segmentRoutingSubnetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config onosPortConfig
+	err := onosRequest(ctx, r.client, "GET", netcfgPath("ports", state.ConnectPoint.ValueString(), "interfaces"), nil, &config.Interfaces)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Port Subnets",
+			"Could not read the interfaces config of port "+state.ConnectPoint.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if len(config.Interfaces) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = state.ConnectPoint
+	resp.Diagnostics.Append(flattenPortConfig(ctx, config, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *segmentRoutingSubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan segmentRoutingSubnetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := expandPortConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "POST", netcfgSubjectPath("ports", plan.ConnectPoint.ValueString()), config, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos Port Subnets",
+			"Could not apply the interfaces config of port "+plan.ConnectPoint.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.ConnectPoint
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *segmentRoutingSubnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state segmentRoutingSubnetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosRequest(ctx, r.client, "DELETE", netcfgPath("ports", state.ConnectPoint.ValueString(), "interfaces"), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos port subnets",
+			"Could not delete the interfaces config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *segmentRoutingSubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connect_point"), req.ID)...)
+}
+
+// expandPortConfig builds the netcfg of a port holding a single interface
+// from the model.
+func expandPortConfig(ctx context.Context, m segmentRoutingSubnetResourceModel) (onosPortConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	iface := onosInterface{
+		Name:         m.Name.ValueString(),
+		VlanUntagged: m.VlanUntagged.ValueInt64Pointer(),
+		VlanNative:   m.VlanNative.ValueInt64Pointer(),
+	}
+	diags.Append(m.Subnets.ElementsAs(ctx, &iface.IPs, false)...)
+	if !m.VlanTagged.IsNull() {
+		diags.Append(m.VlanTagged.ElementsAs(ctx, &iface.VlanTagged, false)...)
+	}
+	return onosPortConfig{Interfaces: []onosInterface{iface}}, diags
+}
+
+// flattenPortConfig copies the first interface of the netcfg of a port into
+// the model.
+func flattenPortConfig(ctx context.Context, config onosPortConfig, m *segmentRoutingSubnetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	iface := config.Interfaces[0]
+
+	var d diag.Diagnostics
+	m.Name = optionalString(iface.Name)
+	m.Subnets, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(iface.IPs))
+	diags.Append(d...)
+	m.VlanUntagged = types.Int64PointerValue(iface.VlanUntagged)
+	m.VlanNative = types.Int64PointerValue(iface.VlanNative)
+	m.VlanTagged = types.SetNull(types.Int64Type)
+	if len(iface.VlanTagged) > 0 {
+		m.VlanTagged, d = types.SetValueFrom(ctx, types.Int64Type, iface.VlanTagged)
+		diags.Append(d...)
+	}
+	return diags
+}
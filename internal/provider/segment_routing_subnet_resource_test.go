@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSegmentRoutingSubnetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_subnet" "test" {
+					connect_point = "of:0000000000000001/3"
+					subnets       = ["10.0.1.254"]
+					vlan_untagged = 10
+				  }
+`,
+				ExpectError: regexp.MustCompile(`must be an IP address with a prefix length`),
+			},
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_subnet" "test" {
+					connect_point = "of:0000000000000001/3"
+					subnets       = ["10.0.1.254/24"]
+					vlan_untagged = 10
+					vlan_tagged   = [20]
+				  }
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_subnet" "test" {
+					connect_point = "of:0000000000000001/3"
+					name          = "leaf1-h1"
+					subnets       = ["10.0.1.254/24", "2001:db8:1::254/64"]
+					vlan_untagged = 10
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_segment_routing_subnet.test", "id", "of:0000000000000001/3"),
+					resource.TestCheckResourceAttr("onos_segment_routing_subnet.test", "subnets.#", "2"),
+					resource.TestCheckTypeSetElemAttr("onos_segment_routing_subnet.test", "subnets.*", "10.0.1.254/24"),
+					resource.TestCheckResourceAttr("onos_segment_routing_subnet.test", "vlan_untagged", "10"),
+					resource.TestCheckResourceAttrSet("onos_segment_routing_subnet.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_segment_routing_subnet.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_subnet" "test" {
+					connect_point = "of:0000000000000001/3"
+					name          = "leaf1-h1"
+					subnets       = ["10.0.1.254/24"]
+					vlan_tagged   = [20, 30]
+					vlan_native   = 20
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_segment_routing_subnet.test", "subnets.#", "1"),
+					resource.TestCheckNoResourceAttr("onos_segment_routing_subnet.test", "vlan_untagged"),
+					resource.TestCheckResourceAttr("onos_segment_routing_subnet.test", "vlan_tagged.#", "2"),
+					resource.TestCheckResourceAttr("onos_segment_routing_subnet.test", "vlan_native", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestPortConfigRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	subnets, _ := types.SetValueFrom(ctx, types.StringType, []string{"10.0.1.254/24"})
+	tagged, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{20, 30})
+
+	tests := []struct {
+		name  string
+		model segmentRoutingSubnetResourceModel
+	}{
+		{
+			name: "untagged",
+			model: segmentRoutingSubnetResourceModel{
+				Name:         types.StringValue("leaf1-h1"),
+				Subnets:      subnets,
+				VlanUntagged: types.Int64Value(10),
+				VlanTagged:   types.SetNull(types.Int64Type),
+				VlanNative:   types.Int64Null(),
+			},
+		},
+		{
+			name: "tagged",
+			model: segmentRoutingSubnetResourceModel{
+				Name:         types.StringNull(),
+				Subnets:      subnets,
+				VlanUntagged: types.Int64Null(),
+				VlanTagged:   tagged,
+				VlanNative:   types.Int64Value(20),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, diags := expandPortConfig(ctx, tt.model)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(config.Interfaces) != 1 {
+				t.Fatalf("expected 1 interface, got %d", len(config.Interfaces))
+			}
+
+			var got segmentRoutingSubnetResourceModel
+			if diags := flattenPortConfig(ctx, config, &got); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !got.Name.Equal(tt.model.Name) || !got.Subnets.Equal(tt.model.Subnets) ||
+				!got.VlanUntagged.Equal(tt.model.VlanUntagged) || !got.VlanTagged.Equal(tt.model.VlanTagged) ||
+				!got.VlanNative.Equal(tt.model.VlanNative) {
+				t.Errorf("expected %+v, got %+v", tt.model, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &segmentRoutingXconnectResource{}
+	_ resource.ResourceWithConfigure   = &segmentRoutingXconnectResource{}
+	_ resource.ResourceWithImportState = &segmentRoutingXconnectResource{}
+)
+
+// segmentRoutingApp is the REST API of the segment routing app.
+const segmentRoutingApp = "segmentrouting"
+
+// segmentRoutingXconnectResource is the resource implementation.
+type segmentRoutingXconnectResource struct {
+	client  *onosclient.Client
+	version onosVersion
+}
+
+// NewSegmentRoutingXconnectResource is a helper function to simplify the provider implementation.
+func NewSegmentRoutingXconnectResource() resource.Resource {
+	return &segmentRoutingXconnectResource{}
+}
+
+type segmentRoutingXconnectResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DeviceID    types.String `tfsdk:"device_id"`
+	VlanID      types.Int64  `tfsdk:"vlan_id"`
+	Endpoints   types.Set    `tfsdk:"endpoints"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// onosXconnects maps the segment routing xconnect response.
+type onosXconnects struct {
+	Xconnects []onosXconnect `json:"xconnects"`
+}
+
+// onosXconnect maps a segment routing xconnect. ONOS encodes the VLAN ID
+// either as a number or as a string, so it is kept as raw JSON.
+type onosXconnect struct {
+	DeviceID  string          `json:"deviceId"`
+	VlanID    json.RawMessage `json:"vlanId"`
+	Endpoints []string        `json:"endpoints,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *segmentRoutingXconnectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment_routing_xconnect"
+}
+
+// Schema defines the schema for the resource.
+func (r *segmentRoutingXconnectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a segment routing cross connect bridging two ports of a device on a VLAN. Requires ONOS 2.2 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the cross connect in the form device/vlan.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				Description: "VLAN ID of the cross connected traffic.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"endpoints": schema.SetAttribute{
+				Description: "The two endpoints of the cross connect, given as port numbers or as load balancer IDs, e.g. LB:5.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(2, 2),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the cross connect.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *segmentRoutingXconnectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+	r.version = data.Version
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *segmentRoutingXconnectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan segmentRoutingXconnectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(checkFeature(r.version, featureXconnect)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	xconnect := expandXconnect(plan)
+	resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &xconnect.Endpoints, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosAppRequest(ctx, r.client, segmentRoutingApp, "POST", "/xconnect", xconnect, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating xconnect",
+			"Could not create xconnect, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%d", plan.DeviceID.ValueString(), plan.VlanID.ValueInt64()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *segmentRoutingXconnectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state segmentRoutingXconnectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var xconnects onosXconnects
+	err := onosAppRequest(ctx, r.client, segmentRoutingApp, "GET", "/xconnect", nil, &xconnects)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos Xconnect",
+			"Could not read xconnect "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	vlanID := strconv.FormatInt(state.VlanID.ValueInt64(), 10)
+	for _, xconnect := range xconnects.Xconnects {
+		if xconnect.DeviceID != state.DeviceID.ValueString() || strings.Trim(string(xconnect.VlanID), `"`) != vlanID {
+			continue
+		}
+
+		state.Endpoints, diags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(xconnect.Endpoints))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The xconnect was removed outside of Terraform
+	resp.State.RemoveResource(ctx)
+}
+
+// Update replaces the endpoints of the cross connect.
+func (r *segmentRoutingXconnectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan segmentRoutingXconnectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(checkFeature(r.version, featureXconnect)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	xconnect := expandXconnect(plan)
+	resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &xconnect.Endpoints, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosAppRequest(ctx, r.client, segmentRoutingApp, "POST", "/xconnect", xconnect, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos Xconnect",
+			"Could not update xconnect "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *segmentRoutingXconnectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state segmentRoutingXconnectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := onosAppRequest(ctx, r.client, segmentRoutingApp, "DELETE", "/xconnect", expandXconnect(state), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos xconnect",
+			"Could not delete xconnect, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *segmentRoutingXconnectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Pass in the device id and VLAN id, e.g. terraform import onos_segment_routing_xconnect.olt "of:0000000000000001/100"
+	idx := strings.LastIndex(req.ID, "/")
+	var vlanID int64
+	var err error
+	if idx > 0 {
+		vlanID, err = strconv.ParseInt(req.ID[idx+1:], 10, 64)
+	}
+	if idx <= 0 || err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: DeviceID/VlanID. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID[:idx])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vlan_id"), vlanID)...)
+}
+
+// expandXconnect builds the xconnect key of the model; endpoints are added
+// by the caller when needed.
+func expandXconnect(m segmentRoutingXconnectResourceModel) onosXconnect {
+	return onosXconnect{
+		DeviceID: m.DeviceID.ValueString(),
+		VlanID:   json.RawMessage(strconv.Quote(strconv.FormatInt(m.VlanID.ValueInt64(), 10))),
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSegmentRoutingXconnectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLiveONOS(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_xconnect" "test" {
+					device_id = "of:0000000000000001"
+					vlan_id   = 100
+					endpoints = ["1", "2"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_segment_routing_xconnect.test", "id", "of:0000000000000001/100"),
+					resource.TestCheckResourceAttr("onos_segment_routing_xconnect.test", "endpoints.#", "2"),
+					resource.TestCheckResourceAttrSet("onos_segment_routing_xconnect.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_segment_routing_xconnect.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "onos_segment_routing_xconnect" "test" {
+					device_id = "of:0000000000000001"
+					vlan_id   = 100
+					endpoints = ["1", "3"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("onos_segment_routing_xconnect.test", "endpoints.*", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
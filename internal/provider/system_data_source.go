@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &systemDataSource{}
+	_ datasource.DataSourceWithConfigure = &systemDataSource{}
+)
+
+// NewSystemDataSource is a helper function to simplify the provider implementation.
+func NewSystemDataSource() datasource.DataSource {
+	return &systemDataSource{}
+}
+
+// systemDataSource is the data source implementation.
+type systemDataSource struct {
+	client *onosclient.Client
+}
+
+type systemDataSourceModel struct {
+	Node      types.String `tfsdk:"node"`
+	Version   types.String `tfsdk:"version"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Nodes     types.Int64  `tfsdk:"nodes"`
+	Devices   types.Int64  `tfsdk:"devices"`
+	Links     types.Int64  `tfsdk:"links"`
+	Hosts     types.Int64  `tfsdk:"hosts"`
+	SCCs      types.Int64  `tfsdk:"sccs"`
+	Flows     types.Int64  `tfsdk:"flows"`
+	Intents   types.Int64  `tfsdk:"intents"`
+}
+
+// Metadata returns the data source type name.
+func (d *systemDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system"
+}
+
+// Schema defines the schema for the data source.
+func (d *systemDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the system information of the ONOS node the provider is connected to.",
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Description: "IP address of the ONOS node.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "ONOS version, e.g. 2.7.0.",
+				Computed:    true,
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the ONOS cluster.",
+				Computed:    true,
+			},
+			"nodes": schema.Int64Attribute{
+				Description: "Number of nodes of the cluster.",
+				Computed:    true,
+			},
+			"devices": schema.Int64Attribute{
+				Description: "Number of devices.",
+				Computed:    true,
+			},
+			"links": schema.Int64Attribute{
+				Description: "Number of links.",
+				Computed:    true,
+			},
+			"hosts": schema.Int64Attribute{
+				Description: "Number of hosts.",
+				Computed:    true,
+			},
+			"sccs": schema.Int64Attribute{
+				Description: "Number of strongly connected components of the topology.",
+				Computed:    true,
+			},
+			"flows": schema.Int64Attribute{
+				Description: "Number of flow rules.",
+				Computed:    true,
+			},
+			"intents": schema.Int64Attribute{
+				Description: "Number of intents.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *systemDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *systemDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var system onosSystem
+	err := onosRequest(ctx, d.client, "GET", "/system", nil, &system)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Onos System Information",
+			err.Error(),
+		)
+		return
+	}
+
+	state := systemDataSourceModel{
+		Node:      types.StringValue(system.Node),
+		Version:   types.StringValue(system.Version),
+		ClusterID: types.StringValue(system.ClusterID),
+		Nodes:     types.Int64Value(system.Nodes),
+		Devices:   types.Int64Value(system.Devices),
+		Links:     types.Int64Value(system.Links),
+		Hosts:     types.Int64Value(system.Hosts),
+		SCCs:      types.Int64Value(system.SCCs),
+		Flows:     types.Int64Value(system.Flows),
+		Intents:   types.Int64Value(system.Intents),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSystemDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "onos_system" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.onos_system.test", "version", regexp.MustCompile(`^2\.`)),
+					resource.TestCheckResourceAttr("data.onos_system.test", "nodes", "1"),
+					// Mininet topology of examples/docker-compose.yaml
+					resource.TestCheckResourceAttr("data.onos_system.test", "hosts", "4"),
+					resource.TestCheckResourceAttrSet("data.onos_system.test", "cluster_id"),
+				),
+			},
+		},
+	})
+}
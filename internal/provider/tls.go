@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// tlsOptions holds the TLS settings of the connection to the ONOS API.
+type tlsOptions struct {
+	CACertFile         string
+	CACertPEM          string
+	ClientCert         string
+	ClientKey          string
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// isSet reports whether any TLS setting differs from the Go defaults.
+func (o tlsOptions) isSet() bool {
+	return o != tlsOptions{}
+}
+
+// config builds the TLS configuration of the options. The client certificate
+// and key are either PEM encoded or paths to PEM files.
+func (o tlsOptions) config() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         o.ServerName,
+		InsecureSkipVerify: o.InsecureSkipVerify,
+	}
+
+	if o.CACertFile != "" && o.CACertPEM != "" {
+		return nil, errors.New("only one of ca_cert_file and ca_cert_pem can be set")
+	}
+	caPEM := []byte(o.CACertPEM)
+	if o.CACertFile != "" {
+		var err error
+		caPEM, err = os.ReadFile(o.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA certificate: %w", err)
+		}
+	}
+	if len(caPEM) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no PEM encoded certificate found in the CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (o.ClientCert == "") != (o.ClientKey == "") {
+		return nil, errors.New("client_cert and client_key must be set together")
+	}
+	if o.ClientCert != "" {
+		certPEM, err := pemOrFile(o.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("reading client certificate: %w", err)
+		}
+		keyPEM, err := pemOrFile(o.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("reading client key: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// pemOrFile returns value when it is PEM encoded, and the content of the file
+// it names otherwise.
+func pemOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// configureTLS sets the TLS configuration of the options on the transport
+// of httpClient.
+func configureTLS(httpClient *http.Client, opts tlsOptions) error {
+	if !opts.isSet() {
+		return nil
+	}
+	cfg, err := opts.config()
+	if err != nil {
+		return err
+	}
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+	transport.TLSClientConfig = cfg
+	httpClient.Transport = transport
+	return nil
+}
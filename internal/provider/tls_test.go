@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+)
+
+// newTestTLSServer starts an ONOS API stand-in over HTTPS. When clientCA is
+// set the server requires a client certificate signed by it.
+func newTestTLSServer(t *testing.T, clientCA *x509.Certificate) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"2.7.0"}`))
+	}))
+	if clientCA != nil {
+		pool := x509.NewCertPool()
+		pool.AddCert(clientCA)
+		server.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  pool,
+		}
+	}
+	// Rejected handshakes are expected, keep them out of the test output.
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newTestClientCert returns a self-signed client certificate and its key,
+// both PEM encoded.
+func newTestClientCert(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return cert, string(certPEM), string(keyPEM)
+}
+
+func serverCAPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// requestWithTLS configures a client with opts and sends a request to server.
+func requestWithTLS(server *httptest.Server, opts tlsOptions) error {
+	client, err := onosclient.NewClient(server.URL+"/onos/v1", "onos", "rocks")
+	if err != nil {
+		return err
+	}
+	if err := configureTLS(client.HTTPClient, opts); err != nil {
+		return err
+	}
+	return onosRequest(context.Background(), client, "GET", "/system", nil, nil)
+}
+
+func TestConfigureTLS(t *testing.T) {
+	server := newTestTLSServer(t, nil)
+	caPEM := serverCAPEM(server)
+
+	tests := []struct {
+		name    string
+		opts    tlsOptions
+		wantErr string
+	}{
+		{
+			name:    "untrusted server certificate",
+			opts:    tlsOptions{},
+			wantErr: "certificate",
+		},
+		{
+			name: "CA PEM",
+			opts: tlsOptions{CACertPEM: caPEM},
+		},
+		{
+			name: "CA file",
+			opts: tlsOptions{CACertFile: writeTestFile(t, "ca.pem", caPEM)},
+		},
+		{
+			name: "insecure skip verify",
+			opts: tlsOptions{InsecureSkipVerify: true},
+		},
+		{
+			// The httptest certificate is issued for example.com.
+			name: "server name",
+			opts: tlsOptions{CACertPEM: caPEM, ServerName: "example.com"},
+		},
+		{
+			name:    "wrong server name",
+			opts:    tlsOptions{CACertPEM: caPEM, ServerName: "onos.example.org"},
+			wantErr: "onos.example.org",
+		},
+		{
+			name:    "conflicting CA settings",
+			opts:    tlsOptions{CACertPEM: caPEM, CACertFile: "ca.pem"},
+			wantErr: "only one of ca_cert_file and ca_cert_pem",
+		},
+		{
+			name:    "invalid CA PEM",
+			opts:    tlsOptions{CACertPEM: "not a certificate"},
+			wantErr: "no PEM encoded certificate",
+		},
+		{
+			name:    "missing CA file",
+			opts:    tlsOptions{CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+			wantErr: "reading CA certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := requestWithTLS(server, tt.opts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestConfigureTLSClientCertificate(t *testing.T) {
+	clientCA, certPEM, keyPEM := newTestClientCert(t)
+	server := newTestTLSServer(t, clientCA)
+	caPEM := serverCAPEM(server)
+
+	tests := []struct {
+		name    string
+		opts    tlsOptions
+		wantErr string
+	}{
+		{
+			name:    "missing client certificate",
+			opts:    tlsOptions{CACertPEM: caPEM},
+			wantErr: "certificate",
+		},
+		{
+			name: "PEM client certificate",
+			opts: tlsOptions{CACertPEM: caPEM, ClientCert: certPEM, ClientKey: keyPEM},
+		},
+		{
+			name: "client certificate files",
+			opts: tlsOptions{
+				CACertPEM:  caPEM,
+				ClientCert: writeTestFile(t, "client.pem", certPEM),
+				ClientKey:  writeTestFile(t, "client-key.pem", keyPEM),
+			},
+		},
+		{
+			name:    "client certificate without key",
+			opts:    tlsOptions{CACertPEM: caPEM, ClientCert: certPEM},
+			wantErr: "client_cert and client_key must be set together",
+		},
+		{
+			name:    "mismatched client key",
+			opts:    tlsOptions{CACertPEM: caPEM, ClientCert: certPEM, ClientKey: caPEM},
+			wantErr: "loading client certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := requestWithTLS(server, tt.opts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
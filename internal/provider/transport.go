@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRequestTimeout  = 10 * time.Second
+	defaultMaxRetries      = 3
+	defaultRetryBackoffMin = time.Second
+	defaultRetryBackoffMax = 30 * time.Second
+)
+
+// transportOptions holds the timeout, retry and rate limit settings of the
+// requests to the ONOS API.
+type transportOptions struct {
+	RequestTimeout    time.Duration
+	MaxRetries        int64
+	RetryBackoffMin   time.Duration
+	RetryBackoffMax   time.Duration
+	RequestsPerSecond int64
+}
+
+// configureTransport wraps the transport of httpClient to apply the options.
+// The request timeout is applied per attempt by the transport, so the client
+// timeout is disabled to not cut the retries short.
+func configureTransport(httpClient *http.Client, opts transportOptions) {
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	transport := &retryTransport{
+		next:       next,
+		timeout:    opts.RequestTimeout,
+		maxRetries: opts.MaxRetries,
+		backoffMin: opts.RetryBackoffMin,
+		backoffMax: opts.RetryBackoffMax,
+	}
+	if opts.RequestsPerSecond > 0 {
+		transport.limiter = &rateLimiter{interval: time.Second / time.Duration(opts.RequestsPerSecond)}
+	}
+
+	httpClient.Transport = transport
+	httpClient.Timeout = 0
+}
+
+// retryTransport retries the requests failing with a 5xx or 429 status or a
+// connection reset, waiting an exponential backoff between attempts. Other
+// errors, including all 4xx statuses but 429, are returned right away. As
+// ONOS may have applied a POST whose response failed, a POST is only retried
+// on a 429 status or when it could not be sent at all.
+type retryTransport struct {
+	next       http.RoundTripper
+	timeout    time.Duration
+	maxRetries int64
+	backoffMin time.Duration
+	backoffMax time.Duration
+	limiter    *rateLimiter
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := int64(0); ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := t.roundTrip(req)
+		if attempt >= t.maxRetries || !retryable(req.Method, res, err) || (req.Body != nil && req.GetBody == nil) {
+			return res, err
+		}
+
+		wait := t.backoff(attempt, res)
+		if res != nil {
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// roundTrip sends one attempt of req with a fresh copy of its body, cancelled
+// after the request timeout unless the response body is closed first.
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if t.timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), t.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+
+	attempt := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		attempt.Body = body
+	}
+
+	res, err := t.next.RoundTrip(attempt)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// backoff returns the wait before the retry following attempt, doubling from
+// the minimum up to the maximum backoff. A Retry-After header in seconds
+// takes precedence while it stays under the maximum backoff.
+func (t *retryTransport) backoff(attempt int64, res *http.Response) time.Duration {
+	if res != nil {
+		if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			if wait := time.Duration(seconds) * time.Second; wait <= t.backoffMax {
+				return wait
+			}
+		}
+	}
+
+	wait := t.backoffMin
+	for i := int64(0); i < attempt && wait < t.backoffMax; i++ {
+		wait *= 2
+	}
+	if wait > t.backoffMax {
+		wait = t.backoffMax
+	}
+	return wait
+}
+
+// retryable reports whether the result of an attempt is a transient failure
+// that is safe to retry with method.
+func retryable(method string, res *http.Response, err error) bool {
+	var opErr *net.OpError
+	if err != nil && errors.As(err, &opErr) && opErr.Op == "dial" {
+		// The request was never sent.
+		return true
+	}
+	if res != nil && res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !idempotent(method) {
+		return false
+	}
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	return res.StatusCode >= http.StatusInternalServerError
+}
+
+// idempotent reports whether sending a request with method twice has the same
+// effect as sending it once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// cancelOnClose releases the context of a request once its response body is
+// closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// rateLimiter spaces the requests by a fixed interval.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// wait blocks until the next request is allowed or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(at)):
+		return nil
+	}
+}
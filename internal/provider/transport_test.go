@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+)
+
+// newTestTransportClient returns a client of server with the transport
+// options applied and fast retries.
+func newTestTransportClient(t *testing.T, server *httptest.Server, opts transportOptions) *onosclient.Client {
+	t.Helper()
+
+	if opts.RetryBackoffMin == 0 {
+		opts.RetryBackoffMin = time.Millisecond
+		opts.RetryBackoffMax = 5 * time.Millisecond
+	}
+	client, err := onosclient.NewClient(server.URL+"/onos/v1", "onos", "rocks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	configureTransport(client.HTTPClient, opts)
+	return client
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int
+		retries  int64
+		wantErr  string
+		wantHits int64
+	}{
+		{
+			name:     "success",
+			method:   "PUT",
+			statuses: []int{http.StatusOK},
+			retries:  3,
+			wantHits: 1,
+		},
+		{
+			name:     "transient unavailable",
+			method:   "PUT",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			retries:  3,
+			wantHits: 3,
+		},
+		{
+			name:     "too many requests",
+			method:   "PUT",
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			retries:  3,
+			wantHits: 2,
+		},
+		{
+			name:     "retries exhausted",
+			method:   "PUT",
+			statuses: []int{http.StatusInternalServerError},
+			retries:  2,
+			wantErr:  "status: 500",
+			wantHits: 3,
+		},
+		{
+			name:     "retries disabled",
+			method:   "PUT",
+			statuses: []int{http.StatusServiceUnavailable},
+			retries:  0,
+			wantErr:  "status: 503",
+			wantHits: 1,
+		},
+		{
+			name:     "client error",
+			method:   "PUT",
+			statuses: []int{http.StatusNotFound},
+			retries:  3,
+			wantErr:  "status: 404",
+			wantHits: 1,
+		},
+		{
+			name:     "post unavailable",
+			method:   "POST",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			retries:  3,
+			wantErr:  "status: 503",
+			wantHits: 1,
+		},
+		{
+			name:     "post too many requests",
+			method:   "POST",
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			retries:  3,
+			wantHits: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int64
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hit := hits.Add(1)
+				status := tt.statuses[len(tt.statuses)-1]
+				if int(hit) <= len(tt.statuses) {
+					status = tt.statuses[hit-1]
+				}
+				// The request body must be sent again on every attempt.
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"key":"value"}` {
+					t.Errorf("attempt %d: unexpected body %q", hit, body)
+				}
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			client := newTestTransportClient(t, server, transportOptions{MaxRetries: tt.retries})
+			err := onosRequest(context.Background(), client, tt.method, "/test", map[string]string{"key": "value"}, nil)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+			if hits.Load() != tt.wantHits {
+				t.Fatalf("expected %d attempts, got %d", tt.wantHits, hits.Load())
+			}
+		})
+	}
+}
+
+func TestRetryTransportConnectionReset(t *testing.T) {
+	var hits atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if hits.Add(1) == 1 {
+			// Drop the connection without answering.
+			hijacker, ok := w.(http.Hijacker)
+			if !ok {
+				t.Error("response writer does not support hijacking")
+				return
+			}
+			conn, _, err := hijacker.Hijack()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.Close()
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestTransportClient(t, server, transportOptions{MaxRetries: 1})
+	if err := onosRequest(context.Background(), client, "GET", "/test", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hits.Load() != 2 {
+		t.Fatalf("expected 2 attempts, got %d", hits.Load())
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	tests := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{name: "get unavailable", method: "GET", status: http.StatusServiceUnavailable, want: true},
+		{name: "delete reset", method: "DELETE", err: readErr, want: true},
+		{name: "put eof", method: "PUT", err: io.EOF, want: true},
+		{name: "get not found", method: "GET", status: http.StatusNotFound},
+		{name: "post unavailable", method: "POST", status: http.StatusServiceUnavailable},
+		{name: "post reset", method: "POST", err: readErr},
+		{name: "post eof", method: "POST", err: io.ErrUnexpectedEOF},
+		{name: "post too many requests", method: "POST", status: http.StatusTooManyRequests, want: true},
+		{name: "post dial error", method: "POST", err: dialErr, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res *http.Response
+			if tt.err == nil {
+				res = &http.Response{StatusCode: tt.status}
+			}
+			if got := retryable(tt.method, res, tt.err); got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransportRequestTimeout(t *testing.T) {
+	var hits atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := newTestTransportClient(t, server, transportOptions{RequestTimeout: 20 * time.Millisecond, MaxRetries: 3})
+	err := onosRequest(context.Background(), client, "GET", "/test", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+	if hits.Load() != 1 {
+		t.Fatalf("expected timeouts not to be retried, got %d attempts", hits.Load())
+	}
+}
+
+func TestRetryTransportRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestTransportClient(t, server, transportOptions{RequestsPerSecond: 20})
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := onosRequest(context.Background(), client, "GET", "/test", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	// The first request is sent right away, the next four 50ms apart.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected 5 requests at 20 per second to take at least 200ms, took %s", elapsed)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{backoffMin: time.Second, backoffMax: 5 * time.Second}
+
+	tests := []struct {
+		attempt    int64
+		retryAfter string
+		want       time.Duration
+	}{
+		{attempt: 0, want: time.Second},
+		{attempt: 1, want: 2 * time.Second},
+		{attempt: 2, want: 4 * time.Second},
+		{attempt: 3, want: 5 * time.Second},
+		{attempt: 60, want: 5 * time.Second},
+		{attempt: 0, retryAfter: "3", want: 3 * time.Second},
+		{attempt: 0, retryAfter: "60", want: time.Second},
+		{attempt: 1, retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT", want: 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		res := &http.Response{Header: http.Header{}}
+		if tt.retryAfter != "" {
+			res.Header.Set("Retry-After", tt.retryAfter)
+		}
+		if got := transport.backoff(tt.attempt, res); got != tt.want {
+			t.Errorf("backoff(%d, Retry-After %q) = %s, want %s", tt.attempt, tt.retryAfter, got, tt.want)
+		}
+	}
+}
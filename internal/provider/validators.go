@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// macAddressValidator accepts colon separated MAC addresses as ONOS encodes
+// them, e.g. 00:00:00:00:00:01.
+var macAddressValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`),
+	"must be a MAC address of the form 00:00:00:00:00:01",
+)
+
+// connectPointValidator accepts connect points of the form device/port, e.g.
+// of:0000000000000001/1.
+var connectPointValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^[^/]+/[^/]+$`),
+	"must be a connect point of the form of:0000000000000001/1",
+)
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator validates that a string is an IP address, optionally of
+// a single address family.
+type ipAddressValidator struct {
+	// version is 4 or 6 to restrict the address family, or 0 for either.
+	version int
+	// multicast requires a multicast group address.
+	multicast bool
+}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	kind := "IP"
+	if v.version != 0 {
+		kind = fmt.Sprintf("IPv%d", v.version)
+	}
+	if v.multicast {
+		return "value must be a multicast " + kind + " address"
+	}
+	return "value must be an " + kind + " address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	ip := net.ParseIP(req.ConfigValue.ValueString())
+	valid := ip != nil
+	switch v.version {
+	case 4:
+		valid = valid && ip.To4() != nil
+	case 6:
+		valid = valid && ip.To4() == nil
+	}
+	if v.multicast {
+		valid = valid && ip.IsMulticast()
+	}
+	if !valid {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = prefixValidator{}
+
+// prefixValidator validates that a string is an IP prefix in CIDR notation
+// without host bits, e.g. 10.0.1.0/24 but not 10.0.1.5/24.
+type prefixValidator struct {
+	// interfaceAddress accepts host bits, as in the address of an interface
+	// with the length of its subnet, e.g. 10.0.1.254/24.
+	interfaceAddress bool
+}
+
+func (v prefixValidator) Description(_ context.Context) string {
+	if v.interfaceAddress {
+		return "value must be an IP address with a prefix length in CIDR notation"
+	}
+	return "value must be an IP prefix in CIDR notation"
+}
+
+func (v prefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v prefixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	ip, ipNet, err := net.ParseCIDR(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Prefix",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+		return
+	}
+
+	// ONOS stores the network address, so a prefix with host bits set would
+	// never match the route read back.
+	if !v.interfaceAddress && !ip.Equal(ipNet.IP) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Prefix",
+			fmt.Sprintf("Attribute %s must not have host bits set, use %q instead of %q", req.Path, ipNet.String(), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a positive Go duration, e.g.
+// 30s or 1m30s.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 30s or 1m30s"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPrefixValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator prefixValidator
+		value     types.String
+		wantError bool
+	}{
+		{name: "ipv4", value: types.StringValue("10.0.1.0/24")},
+		{name: "ipv6", value: types.StringValue("2001:db8::/32")},
+		{name: "host route", value: types.StringValue("10.0.1.5/32")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "host bits", value: types.StringValue("10.0.1.5/24"), wantError: true},
+		{name: "ipv6 host bits", value: types.StringValue("2001:db8::1/64"), wantError: true},
+		{name: "no length", value: types.StringValue("10.0.1.0"), wantError: true},
+		{name: "invalid", value: types.StringValue("not-a-prefix"), wantError: true},
+		{name: "interface address", validator: prefixValidator{interfaceAddress: true}, value: types.StringValue("10.0.1.254/24")},
+		{name: "interface address without length", validator: prefixValidator{interfaceAddress: true}, value: types.StringValue("10.0.1.254"), wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("prefix"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error %t, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	onosclient "github.com/ctjnkns/onos-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vplsResource{}
+	_ resource.ResourceWithConfigure   = &vplsResource{}
+	_ resource.ResourceWithImportState = &vplsResource{}
+)
+
+// vplsApp is the netcfg subject of the VPLS app.
+const vplsApp = "org.onosproject.vpls"
+
+// vplsConfigMu serializes the updates of the VPLS app config. Every onos_vpls
+// resource reads, edits and writes back the whole config, and Terraform applies
+// resources in parallel, so unserialized updates would drop sibling entries.
+var vplsConfigMu sync.Mutex
+
+// errVplsExists is returned when creating a VPLS whose name is taken.
+var errVplsExists = errors.New("VPLS already exists")
+
+// vplsResource is the resource implementation.
+type vplsResource struct {
+	client *onosclient.Client
+}
+
+// NewVplsResource is a helper function to simplify the provider implementation.
+func NewVplsResource() resource.Resource {
+	return &vplsResource{}
+}
+
+type vplsResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Encapsulation types.String `tfsdk:"encapsulation"`
+	Interfaces    types.Set    `tfsdk:"interfaces"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+}
+
+// onosVpls maps a VPLS entry of the VPLS app config.
+type onosVpls struct {
+	Name          string   `json:"name"`
+	Interfaces    []string `json:"interfaces"`
+	Encapsulation string   `json:"encapsulation,omitempty"`
+}
+
+// onosVplsConfig maps the VPLS app config. Entries are kept as raw JSON so
+// sibling VPLS definitions are written back exactly as they were read, and
+// other fields of the config are preserved in Other.
+type onosVplsConfig struct {
+	VplsList []json.RawMessage
+	Other    map[string]json.RawMessage
+}
+
+// Metadata returns the resource type name.
+func (r *vplsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpls"
+}
+
+// Schema defines the schema for the resource.
+func (r *vplsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a VPLS of the ONOS VPLS app. Other VPLS definitions of the app config are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of the VPLS.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the VPLS.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encapsulation": schema.StringAttribute{
+				Description: "Encapsulation of the VPLS traffic: NONE, VLAN or MPLS. Defaults to NONE.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("NONE"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("NONE", "VLAN", "MPLS"),
+				},
+			},
+			"interfaces": schema.SetAttribute{
+				Description: "Names of the member interfaces, as configured in the ports netcfg.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the VPLS.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vplsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*onosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vplsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vplsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpls := onosVpls{
+		Name:          plan.Name.ValueString(),
+		Encapsulation: plan.Encapsulation.ValueString(),
+	}
+	resp.Diagnostics.Append(plan.Interfaces.ElementsAs(ctx, &vpls.Interfaces, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateVplsConfig(ctx, r.client, func(config *onosVplsConfig) (bool, error) {
+		if _, exists := config.find(vpls.Name); exists {
+			return false, errVplsExists
+		}
+		return true, config.set(vpls)
+	})
+	if errors.Is(err, errVplsExists) {
+		resp.Diagnostics.AddError(
+			"Error creating VPLS",
+			"A VPLS named "+vpls.Name+" already exists in the VPLS app config. Import it to manage it with Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating VPLS",
+			"Could not create VPLS "+vpls.Name+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Name
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vplsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vplsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := getVplsConfig(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos VPLS",
+			"Could not read VPLS "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	index, exists := config.find(state.ID.ValueString())
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var vpls onosVpls
+	if err := json.Unmarshal(config.VplsList[index], &vpls); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Onos VPLS",
+			"Could not decode VPLS "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(vpls.Name)
+	state.Encapsulation = types.StringValue("NONE")
+	if vpls.Encapsulation != "" {
+		state.Encapsulation = types.StringValue(strings.ToUpper(vpls.Encapsulation))
+	}
+	state.Interfaces, diags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(vpls.Interfaces))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vplsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vplsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpls := onosVpls{
+		Name:          plan.Name.ValueString(),
+		Encapsulation: plan.Encapsulation.ValueString(),
+	}
+	resp.Diagnostics.Append(plan.Interfaces.ElementsAs(ctx, &vpls.Interfaces, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateVplsConfig(ctx, r.client, func(config *onosVplsConfig) (bool, error) {
+		return true, config.set(vpls)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Onos VPLS",
+			"Could not update VPLS "+vpls.Name+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Name
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vplsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vplsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateVplsConfig(ctx, r.client, func(config *onosVplsConfig) (bool, error) {
+		index, exists := config.find(state.ID.ValueString())
+		if !exists {
+			return false, nil
+		}
+		config.VplsList = append(config.VplsList[:index], config.VplsList[index+1:]...)
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting onos VPLS",
+			"Could not delete VPLS, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *vplsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getVplsConfig reads the VPLS app config. A missing config is returned as
+// an empty one.
+func getVplsConfig(ctx context.Context, client *onosclient.Client) (*onosVplsConfig, error) {
+	config := &onosVplsConfig{Other: map[string]json.RawMessage{}}
+	err := onosRequest(ctx, client, "GET", netcfgPath("apps", vplsApp, "vpls"), nil, &config.Other)
+	if isNotFound(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, ok := config.Other["vplsList"]; ok {
+		if err := json.Unmarshal(raw, &config.VplsList); err != nil {
+			return nil, fmt.Errorf("decoding vplsList: %w", err)
+		}
+		delete(config.Other, "vplsList")
+	}
+	return config, nil
+}
+
+// updateVplsConfig reads the VPLS app config, edits it with update and writes
+// it back when update reports a change. Updates are serialized by vplsConfigMu.
+func updateVplsConfig(ctx context.Context, client *onosclient.Client, update func(*onosVplsConfig) (bool, error)) error {
+	vplsConfigMu.Lock()
+	defer vplsConfigMu.Unlock()
+
+	config, err := getVplsConfig(ctx, client)
+	if err != nil {
+		return err
+	}
+	changed, err := update(config)
+	if err != nil || !changed {
+		return err
+	}
+	return putVplsConfig(ctx, client, config)
+}
+
+// putVplsConfig writes the VPLS app config back.
+func putVplsConfig(ctx context.Context, client *onosclient.Client, config *onosVplsConfig) error {
+	body := make(map[string]any, len(config.Other)+1)
+	for key, value := range config.Other {
+		body[key] = value
+	}
+	body["vplsList"] = append([]json.RawMessage{}, config.VplsList...)
+	return onosRequest(ctx, client, "POST", netcfgPath("apps", vplsApp, "vpls"), body, nil)
+}
+
+// find returns the index of the VPLS with the given name.
+func (c *onosVplsConfig) find(name string) (int, bool) {
+	for i, raw := range c.VplsList {
+		var entry struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(raw, &entry) == nil && entry.Name == name {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// set replaces the VPLS with the same name or appends it.
+func (c *onosVplsConfig) set(vpls onosVpls) error {
+	if vpls.Interfaces == nil {
+		vpls.Interfaces = []string{}
+	}
+	raw, err := json.Marshal(vpls)
+	if err != nil {
+		return err
+	}
+	if index, exists := c.find(vpls.Name); exists {
+		c.VplsList[index] = raw
+		return nil
+	}
+	c.VplsList = append(c.VplsList, raw)
+	return nil
+}
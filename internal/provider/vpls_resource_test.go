@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVplsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing; both VPLS are created in the same apply
+			{
+				Config: providerConfig + `
+				resource "onos_vpls" "test" {
+					name       = "tenant1"
+					interfaces = ["h1", "h2"]
+				  }
+
+				resource "onos_vpls" "sibling" {
+					name          = "tenant2"
+					encapsulation = "VLAN"
+					interfaces    = ["h3"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_vpls.test", "id", "tenant1"),
+					resource.TestCheckResourceAttr("onos_vpls.test", "encapsulation", "NONE"),
+					resource.TestCheckResourceAttr("onos_vpls.test", "interfaces.#", "2"),
+					resource.TestCheckResourceAttr("onos_vpls.sibling", "encapsulation", "VLAN"),
+					resource.TestCheckResourceAttrSet("onos_vpls.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onos_vpls.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing; the sibling must be left untouched
+			{
+				Config: providerConfig + `
+				resource "onos_vpls" "test" {
+					name          = "tenant1"
+					encapsulation = "MPLS"
+					interfaces    = ["h1"]
+				  }
+
+				resource "onos_vpls" "sibling" {
+					name          = "tenant2"
+					encapsulation = "VLAN"
+					interfaces    = ["h3"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onos_vpls.test", "encapsulation", "MPLS"),
+					resource.TestCheckResourceAttr("onos_vpls.test", "interfaces.#", "1"),
+					resource.TestCheckResourceAttr("onos_vpls.sibling", "interfaces.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestUpdateVplsConfigConcurrent(t *testing.T) {
+	_, client := newTestFakeClient(t)
+	ctx := context.Background()
+
+	// Terraform creates the onos_vpls resources of an apply in parallel.
+	const count = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			vpls := onosVpls{Name: fmt.Sprintf("tenant%d", i), Interfaces: []string{fmt.Sprintf("h%d", i)}}
+			errs <- updateVplsConfig(ctx, client, func(config *onosVplsConfig) (bool, error) {
+				return true, config.set(vpls)
+			})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	config, err := getVplsConfig(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < count; i++ {
+		if _, exists := config.find(fmt.Sprintf("tenant%d", i)); !exists {
+			t.Errorf("expected VPLS tenant%d to be kept, got %d VPLS", i, len(config.VplsList))
+		}
+	}
+}